@@ -0,0 +1,42 @@
+// Command backfill-badges evaluates every volunteer's history against the
+// active badge rules and awards what they have already earned. It is safe to
+// run repeatedly; badges a volunteer holds are not awarded twice, and no
+// notifications are sent.
+package main
+
+import (
+	"context"
+	"log"
+	"volunteer-management/internal/config"
+	"volunteer-management/internal/repository/postgres"
+	"volunteer-management/internal/service"
+	"volunteer-management/pkg/database"
+)
+
+func main() {
+	cfg := config.Load()
+
+	db, err := database.NewPostgresConnection(cfg.Database)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatalf("Failed to get underlying *sql.DB: %v", err)
+	}
+	defer sqlDB.Close()
+
+	// Backfilled badges are awarded quietly, so no notification service
+	badgeService := service.NewBadgeService(
+		postgres.NewBadgeRepository(db),
+		postgres.NewVolunteerRepository(db),
+		postgres.NewOrganizationRepository(db),
+		nil,
+	)
+
+	awarded, err := badgeService.Backfill(context.Background())
+	if err != nil {
+		log.Fatalf("Backfill stopped after awarding %d achievements: %v", awarded, err)
+	}
+	log.Printf("Awarded %d achievements", awarded)
+}
@@ -16,7 +16,10 @@ import (
 	"volunteer-management/internal/routes"
 	"volunteer-management/internal/service"
 	"volunteer-management/internal/websocket"
+	"volunteer-management/pkg/cache"
 	"volunteer-management/pkg/database"
+	"volunteer-management/pkg/envelope"
+	"volunteer-management/pkg/storage"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -58,12 +61,30 @@ func main() {
 
 	// Initialize repositories
 	userRepo := &UserRepoAdapter{postgres.NewUserRepository(db)}
+	notificationRepo := postgres.NewNotificationRepository(db)
+	volunteerRepo := postgres.NewVolunteerRepository(db)
+	eventRepo := postgres.NewEventRepository(db)
+	eventRegRepo := postgres.NewEventRegistrationRepository(db)
+	eventFormRepo := postgres.NewEventFormRepository(db)
+	eventCohostRepo := postgres.NewEventCohostRepository(db)
+	feedbackRepo := postgres.NewFeedbackRepository(db)
+	groupRepo := postgres.NewGroupRepository(db)
+	hoursRepo := postgres.NewHoursRepository(db)
+	reliabilityRepo := postgres.NewReliabilityRepository(db)
+	availabilityRepo := postgres.NewAvailabilityRepository(db)
+	badgeRepo := postgres.NewBadgeRepository(db)
+	progressionRepo := postgres.NewProgressionRepository(db)
+	verificationRepo := postgres.NewVerificationRepository(db)
+	certificateRepo := postgres.NewCertificateRepository(db)
+	schoolRepo := postgres.NewSchoolRepository(db)
+	feedRepo := postgres.NewFeedRepository(db)
+	emergencyInfoRepo := postgres.NewEmergencyInfoRepository(db)
+	waiverRepo := postgres.NewWaiverRepository(db)
+	organizationRepo := postgres.NewOrganizationRepository(db)
+	mediaRepo := postgres.NewMediaRepository(db)
+	impactMetricRepo := postgres.NewImpactMetricRepository(db)
 	// Commenting out unused repositories for now
 	/*
-		eventRepo := postgres.NewEventRepository(db)
-		volunteerRepo := postgres.NewVolunteerRepository(db)
-		organizationRepo := postgres.NewOrganizationRepository(db)
-		eventRegRepo := postgres.NewEventRegistrationRepository(db)
 		messageRepo := postgres.NewMessageRepository(db)
 		conversationRepo := postgres.NewConversationRepository(db)
 		analyticsRepo := postgres.NewAnalyticsRepository(db)
@@ -75,25 +96,87 @@ func main() {
 	defer cancel()
 	go wsManager.Run(ctx)
 
+	// Initialize Redis cache
+	redisCache, err := cache.NewRedisCache(cfg.Redis)
+	if err != nil {
+		log.Fatalf("Failed to connect to Redis: %v", err)
+	}
+
+	// Initialize media storage
+	urlSigner := storage.NewURLSigner(cfg.Storage.URLSecret)
+	mediaStorage, err := storage.New(cfg.Storage, urlSigner)
+	if err != nil {
+		log.Fatalf("Failed to initialize media storage: %v", err)
+	}
+
+	// Emergency info stays unavailable until a master key is configured
+	emergencySealer, err := envelope.New(cfg.EmergencyInfo.KeyID, cfg.EmergencyInfo.MasterKey)
+	if err != nil && err != envelope.ErrNoKey {
+		log.Fatalf("Failed to initialize emergency info encryption: %v", err)
+	}
+
 	// Initialize services
 	// Using the adapter to satisfy the interface
 	authService := service.NewAuthService(userRepo, cfg.JWT)
+	cacheService := service.NewCacheService(redisCache)
+	notificationService := service.NewNotificationService(notificationRepo, volunteerRepo, wsManager)
+	recommendationService := service.NewRecommendationService(eventRepo, eventRegRepo, volunteerRepo, availabilityRepo, cfg.Recommendation)
 	eventService := service.NewEventService(db)
-	signupService := service.NewSignupService(db)
+	eventFormService := service.NewEventFormService(eventFormRepo, eventRegRepo, eventService)
+	eventCohostService := service.NewEventCohostService(eventCohostRepo, organizationRepo, eventService, notificationService)
+	progressionService := service.NewProgressionService(progressionRepo, volunteerRepo, notificationService, wsManager, cfg.Progression, eventRegRepo, organizationRepo)
+	feedbackService := service.NewFeedbackService(feedbackRepo, eventService, organizationRepo, volunteerRepo, cfg.Feedback)
+	badgeService := service.NewBadgeService(badgeRepo, volunteerRepo, organizationRepo, notificationService)
+	reliabilityService := service.NewReliabilityService(reliabilityRepo, organizationRepo, volunteerRepo, notificationService, cfg.Reliability, eventRegRepo)
+	verificationService := service.NewVerificationService(verificationRepo, volunteerRepo, organizationRepo, mediaStorage, notificationService, cacheService, cfg.Storage)
+	registrationService := service.NewRegistrationService(eventRegRepo, eventRepo, volunteerRepo, eventFormRepo, waiverRepo, eventCohostRepo, organizationRepo, reliabilityService, notificationService, cacheService, wsManager, cfg.Signup, verificationService)
+	groupService := service.NewGroupService(groupRepo, registrationService, eventService, volunteerRepo, userRepo, notificationService, badgeService, progressionService)
+	waiverService := service.NewWaiverService(waiverRepo, eventService, organizationRepo, volunteerRepo)
+	mediaService := service.NewMediaService(mediaRepo, mediaStorage, urlSigner, cfg.Storage)
+	go mediaService.RunGarbageCollector(ctx)
 	organizationService := service.NewOrganizationService(db)
 	volunteerService := service.NewVolunteerService(db)
+	attendanceService := service.NewAttendanceService(eventRegRepo, eventService, volunteerService, badgeService, progressionService)
+	availabilityService := service.NewAvailabilityService(availabilityRepo, volunteerRepo, eventService, cacheService)
+	hoursService := service.NewHoursService(hoursRepo, feedbackRepo, eventService, organizationRepo, volunteerRepo, notificationService, volunteerService, badgeService, progressionService)
+	certificateService := service.NewCertificateService(certificateRepo, volunteerRepo, userRepo, eventService, cfg.Certificate)
+	schoolService := service.NewSchoolService(schoolRepo, volunteerRepo, userRepo, notificationService)
+	feedService := service.NewFeedService(feedRepo, volunteerRepo, organizationRepo)
+	emergencyInfoService := service.NewEmergencyInfoService(emergencyInfoRepo, volunteerRepo, eventRegRepo, eventService, emergencySealer, cfg.EmergencyInfo)
 	messageService := service.NewMessageService(db)
 	analyticsService := service.NewAnalyticsService(db)
+	impactMetricService := service.NewImpactMetricService(impactMetricRepo, eventService)
 
 	// Initialize handlers
 	handlers := handlers.NewHandlers(
 		authService,
 		eventService,
-		signupService,
+		eventFormService,
+		eventCohostService,
+		feedbackService,
+		attendanceService,
+		registrationService,
+		groupService,
+		hoursService,
+		certificateService,
+		schoolService,
 		organizationService,
+		feedService,
 		volunteerService,
+		reliabilityService,
+		availabilityService,
+		verificationService,
+		emergencyInfoService,
+		badgeService,
+		progressionService,
 		messageService,
 		analyticsService,
+		impactMetricService,
+		notificationService,
+		recommendationService,
+		waiverService,
+		mediaService,
+		cfg.Storage.MaxUploadSize,
 		wsManager,
 	)
 
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 	"time"
 	"volunteer-management/pkg/utils"
 )
@@ -12,6 +13,15 @@ type Config struct {
 	Database DatabaseConfig
 	Redis    RedisConfig
 	JWT      *utils.JWTConfig
+
+	Recommendation RecommendationConfig
+	Storage        StorageConfig
+	Feedback       FeedbackConfig
+	Signup         SignupConfig
+	Reliability    ReliabilityConfig
+	Progression    ProgressionConfig
+	Certificate    CertificateConfig
+	EmergencyInfo  EmergencyInfoConfig
 }
 
 type ServerConfig struct {
@@ -36,6 +46,94 @@ type RedisConfig struct {
 	DB       int
 }
 
+// RecommendationConfig holds the relative weights of each signal used to score
+// events for a volunteer. Weights are normalized, so only their ratios matter.
+type RecommendationConfig struct {
+	SkillWeight    float64
+	InterestWeight float64
+	LocationWeight float64 // text match of locations; there are no coordinates to measure distance
+	ScheduleWeight float64
+	HistoryWeight  float64
+}
+
+// StorageConfig selects where uploaded media is kept. Driver is "local" or
+// "s3"; the S3 settings also work for S3-compatible services such as MinIO.
+type StorageConfig struct {
+	Driver        string
+	UploadDir     string
+	PublicURL     string // base URL the local driver serves files from
+	MaxUploadSize int64
+	MaxPixels     int // uploads declaring more pixels are refused before decoding
+	URLSecret     string
+	URLExpiry     time.Duration
+	OrphanTTL     time.Duration // uploads unreferenced for this long are removed
+	GCInterval    time.Duration
+
+	S3Endpoint  string
+	S3Region    string
+	S3Bucket    string
+	S3AccessKey string
+	S3SecretKey string
+	S3PathStyle bool
+}
+
+// FeedbackConfig controls post-event feedback. Comments containing a blocked
+// term, or reported by ReportThreshold users, wait for an admin's review.
+type FeedbackConfig struct {
+	Window          time.Duration // how long after an event volunteers may leave feedback
+	BlockedTerms    []string
+	ReportThreshold int
+}
+
+// SignupConfig controls schedule conflict checks. Events closer together than
+// TravelBuffer count as overlapping. ConflictPolicy is "reject", which refuses
+// overlapping signups, or "warn", which accepts them once the volunteer
+// confirms the override.
+type SignupConfig struct {
+	TravelBuffer   time.Duration
+	ConflictPolicy string
+}
+
+// ReliabilityConfig controls volunteer reliability scores. Only events that
+// started within Window count; cancelling less than LateCancelWindow before
+// the start counts as a late cancellation. Volunteers get a score once
+// MinEvents events count.
+type ReliabilityConfig struct {
+	Window           time.Duration
+	LateCancelWindow time.Duration
+	MinEvents        int
+}
+
+// ProgressionConfig controls volunteer XP and levels. XP is earned per
+// approved hour, per attended event and per month of the longest run of
+// consecutive active months.
+// LevelThresholds is the total XP needed to reach level 2, 3, and so on.
+type ProgressionConfig struct {
+	XPPerHour        float64
+	XPPerEvent       float64
+	XPPerStreakMonth float64
+	LevelThresholds  []int
+}
+
+// CertificateConfig controls hour transcripts and certificates. Each issued
+// document is signed with SigningSecret and printed with a link to VerifyURL
+// followed by its verification code.
+type CertificateConfig struct {
+	SigningSecret string
+	VerifyURL     string
+}
+
+// EmergencyInfoConfig controls volunteers' emergency contact and medical
+// notes. They are encrypted with MasterKey (32 bytes, base64) and can only be
+// stored once it is set. Coordinators may read them from AccessBefore an
+// event starts until AccessAfter it ends.
+type EmergencyInfoConfig struct {
+	MasterKey    string
+	KeyID        string
+	AccessBefore time.Duration
+	AccessAfter  time.Duration
+}
+
 func Load() *Config {
 	return &Config{
 		Server: ServerConfig{
@@ -62,6 +160,60 @@ func Load() *Config {
 			AccessTokenExpiry:  getDurationEnv("JWT_ACCESS_EXPIRY", 24*time.Hour),
 			RefreshTokenExpiry: getDurationEnv("JWT_REFRESH_EXPIRY", 7*24*time.Hour),
 		},
+		Recommendation: RecommendationConfig{
+			SkillWeight:    getFloatEnv("RECOMMEND_WEIGHT_SKILLS", 0.35),
+			InterestWeight: getFloatEnv("RECOMMEND_WEIGHT_INTERESTS", 0.25),
+			LocationWeight: getFloatEnv("RECOMMEND_WEIGHT_LOCATION", 0.15),
+			ScheduleWeight: getFloatEnv("RECOMMEND_WEIGHT_SCHEDULE", 0.15),
+			HistoryWeight:  getFloatEnv("RECOMMEND_WEIGHT_HISTORY", 0.10),
+		},
+		Storage: StorageConfig{
+			Driver:        getEnv("STORAGE_DRIVER", "local"),
+			UploadDir:     getEnv("UPLOAD_DIR", "./uploads"),
+			PublicURL:     getEnv("MEDIA_PUBLIC_URL", "http://localhost:8080/media/files"),
+			MaxUploadSize: int64(getIntEnv("MAX_UPLOAD_SIZE", 5*1024*1024)),
+			MaxPixels:     getIntEnv("MEDIA_MAX_PIXELS", 40*1000*1000),
+			URLSecret:     getEnv("MEDIA_URL_SECRET", "your-media-secret"),
+			URLExpiry:     getDurationEnv("MEDIA_URL_EXPIRY", time.Hour),
+			OrphanTTL:     getDurationEnv("MEDIA_ORPHAN_TTL", 24*time.Hour),
+			GCInterval:    getDurationEnv("MEDIA_GC_INTERVAL", time.Hour),
+			S3Endpoint:    getEnv("S3_ENDPOINT", ""),
+			S3Region:      getEnv("S3_REGION", "us-east-1"),
+			S3Bucket:      getEnv("S3_BUCKET", ""),
+			S3AccessKey:   getEnv("S3_ACCESS_KEY", ""),
+			S3SecretKey:   getEnv("S3_SECRET_KEY", ""),
+			S3PathStyle:   getBoolEnv("S3_PATH_STYLE", true),
+		},
+		Feedback: FeedbackConfig{
+			Window:          getDurationEnv("FEEDBACK_WINDOW", 30*24*time.Hour),
+			BlockedTerms:    getListEnv("FEEDBACK_BLOCKED_TERMS", nil),
+			ReportThreshold: getIntEnv("FEEDBACK_REPORT_THRESHOLD", 3),
+		},
+		Signup: SignupConfig{
+			TravelBuffer:   getDurationEnv("SIGNUP_TRAVEL_BUFFER", 30*time.Minute),
+			ConflictPolicy: getEnv("SIGNUP_CONFLICT_POLICY", "warn"),
+		},
+		Reliability: ReliabilityConfig{
+			Window:           getDurationEnv("RELIABILITY_WINDOW", 180*24*time.Hour),
+			LateCancelWindow: getDurationEnv("RELIABILITY_LATE_CANCEL_WINDOW", 24*time.Hour),
+			MinEvents:        getIntEnv("RELIABILITY_MIN_EVENTS", 3),
+		},
+		Progression: ProgressionConfig{
+			XPPerHour:        getFloatEnv("PROGRESSION_XP_PER_HOUR", 10),
+			XPPerEvent:       getFloatEnv("PROGRESSION_XP_PER_EVENT", 25),
+			XPPerStreakMonth: getFloatEnv("PROGRESSION_XP_PER_STREAK_MONTH", 20),
+			LevelThresholds:  getIntListEnv("PROGRESSION_LEVEL_THRESHOLDS", []int{100, 250, 500, 1000, 2000, 4000, 8000}),
+		},
+		Certificate: CertificateConfig{
+			SigningSecret: getEnv("CERTIFICATE_SIGNING_SECRET", "your-certificate-secret"),
+			VerifyURL:     getEnv("CERTIFICATE_VERIFY_URL", "http://localhost:8080/api/certificates/verify"),
+		},
+		EmergencyInfo: EmergencyInfoConfig{
+			MasterKey:    getEnv("EMERGENCY_INFO_MASTER_KEY", ""),
+			KeyID:        getEnv("EMERGENCY_INFO_KEY_ID", "v1"),
+			AccessBefore: getDurationEnv("EMERGENCY_INFO_ACCESS_BEFORE", 24*time.Hour),
+			AccessAfter:  getDurationEnv("EMERGENCY_INFO_ACCESS_AFTER", 12*time.Hour),
+		},
 	}
 }
 
@@ -81,6 +233,24 @@ func getIntEnv(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getFloatEnv(key string, defaultValue float64) float64 {
+	if value, exists := os.LookupEnv(key); exists {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
+
+func getBoolEnv(key string, defaultValue bool) bool {
+	if value, exists := os.LookupEnv(key); exists {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
+
 func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
 	if value, exists := os.LookupEnv(key); exists {
 		if duration, err := time.ParseDuration(value); err == nil {
@@ -89,3 +259,38 @@ func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
 	}
 	return defaultValue
 }
+
+// getListEnv reads a comma-separated list, dropping empty entries.
+func getListEnv(key string, defaultValue []string) []string {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultValue
+	}
+
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// getIntListEnv reads a comma-separated list of integers, falling back to the
+// default when any entry is not a number.
+func getIntListEnv(key string, defaultValue []int) []int {
+	items := getListEnv(key, nil)
+	if items == nil {
+		return defaultValue
+	}
+
+	values := make([]int, len(items))
+	for i, item := range items {
+		value, err := strconv.Atoi(item)
+		if err != nil {
+			return defaultValue
+		}
+		values[i] = value
+	}
+	return values
+}
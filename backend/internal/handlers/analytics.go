@@ -3,6 +3,7 @@ package handlers
 import (
 	"net/http"
 	"strconv"
+	"volunteer-management/internal/models"
 	"volunteer-management/internal/service"
 
 	"github.com/gin-gonic/gin"
@@ -147,3 +148,55 @@ func (h *AnalyticsHandler) GetVolunteerStats(c *gin.Context) {
 
 	c.JSON(http.StatusOK, stats)
 }
+
+func (h *AnalyticsHandler) GetOrganizationImpact(c *gin.Context) {
+	orgID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid organization ID"})
+		return
+	}
+
+	h.respondImpactRollup(c, models.MetricRollupFilter{OrganizationID: &orgID})
+}
+
+func (h *AnalyticsHandler) GetCategoryImpact(c *gin.Context) {
+	h.respondImpactRollup(c, models.MetricRollupFilter{Category: c.Param("category")})
+}
+
+func (h *AnalyticsHandler) GetPlatformImpact(c *gin.Context) {
+	h.respondImpactRollup(c, models.MetricRollupFilter{})
+}
+
+// respondImpactRollup applies the shared from/to/interval query parameters to
+// the filter and writes the rollup. Both dates are inclusive.
+func (h *AnalyticsHandler) respondImpactRollup(c *gin.Context, filter models.MetricRollupFilter) {
+	from, err := parseDateQuery(c.Query("from"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format. Use YYYY-MM-DD"})
+		return
+	}
+	to, err := parseDateQuery(c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format. Use YYYY-MM-DD"})
+		return
+	}
+	if !to.IsZero() {
+		to = to.AddDate(0, 0, 1)
+	}
+
+	filter.From = from
+	filter.To = to
+	filter.Interval = c.Query("interval")
+
+	rollup, err := h.analyticsService.GetImpactRollup(c.Request.Context(), filter)
+	if err != nil {
+		if err == service.ErrInvalidRollupInterval {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch impact metrics"})
+		return
+	}
+
+	c.JSON(http.StatusOK, rollup)
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxSignInSheetSize caps uploaded sign-in sheets.
+const maxSignInSheetSize = 1 << 20
+
+type AttendanceHandler struct {
+	attendanceService *service.AttendanceService
+}
+
+func NewAttendanceHandler(attendanceService *service.AttendanceService) *AttendanceHandler {
+	return &AttendanceHandler{
+		attendanceService: attendanceService,
+	}
+}
+
+func (h *AttendanceHandler) Record(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+
+	var input models.BulkAttendanceInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	report, err := h.attendanceService.Record(c.Request.Context(), eventID, &input, userID)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	respondAttendanceReport(c, report)
+}
+
+// ImportSheet accepts a CSV sign-in sheet in the multipart field "file".
+func (h *AttendanceHandler) ImportSheet(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxSignInSheetSize+multipartOverhead)
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		if strings.Contains(err.Error(), "request body too large") {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Sign-in sheet is too large"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "A file is required in the \"file\" field"})
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Could not read uploaded file"})
+		return
+	}
+	defer file.Close()
+
+	userID := c.GetInt64("userID")
+
+	report, err := h.attendanceService.ImportSheet(c.Request.Context(), eventID, file, userID)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	respondAttendanceReport(c, report)
+}
+
+// respondAttendanceReport answers 422 when any row failed, in which case
+// nothing was recorded.
+func respondAttendanceReport(c *gin.Context, report *models.BulkAttendanceReport) {
+	if !report.Applied {
+		c.JSON(http.StatusUnprocessableEntity, report)
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
+
+func respondAttendanceError(c *gin.Context, err error) {
+	switch err {
+	case service.ErrEventNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case service.ErrUnauthorized:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case service.ErrAttendanceBeforeEvent, service.ErrInvalidSheet, service.ErrSheetTooLong:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
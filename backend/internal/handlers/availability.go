@@ -0,0 +1,255 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AvailabilityHandler struct {
+	availabilityService *service.AvailabilityService
+}
+
+func NewAvailabilityHandler(availabilityService *service.AvailabilityService) *AvailabilityHandler {
+	return &AvailabilityHandler{
+		availabilityService: availabilityService,
+	}
+}
+
+func (h *AvailabilityHandler) GetMine(c *gin.Context) {
+	userID := c.GetInt64("userID")
+
+	availability, err := h.availabilityService.GetMine(c.Request.Context(), userID)
+	if err != nil {
+		respondAvailabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, availability)
+}
+
+func (h *AvailabilityHandler) GetForVolunteer(c *gin.Context) {
+	volunteerID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid volunteer ID format"})
+		return
+	}
+
+	availability, err := h.availabilityService.GetForVolunteer(c.Request.Context(), volunteerID)
+	if err != nil {
+		respondAvailabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, availability)
+}
+
+func (h *AvailabilityHandler) SetTimeZone(c *gin.Context) {
+	var input models.SetAvailabilityTimeZoneInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	availability, err := h.availabilityService.SetTimeZone(c.Request.Context(), &input, userID)
+	if err != nil {
+		respondAvailabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, availability)
+}
+
+func (h *AvailabilityHandler) AddWindow(c *gin.Context) {
+	var input models.AvailabilityWindowInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	window, err := h.availabilityService.AddWindow(c.Request.Context(), &input, userID)
+	if err != nil {
+		respondAvailabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, window)
+}
+
+func (h *AvailabilityHandler) UpdateWindow(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid window ID format"})
+		return
+	}
+
+	var input models.AvailabilityWindowInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	window, err := h.availabilityService.UpdateWindow(c.Request.Context(), id, &input, userID)
+	if err != nil {
+		respondAvailabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, window)
+}
+
+func (h *AvailabilityHandler) DeleteWindow(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid window ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	if err := h.availabilityService.DeleteWindow(c.Request.Context(), id, userID); err != nil {
+		respondAvailabilityError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *AvailabilityHandler) AddBlackout(c *gin.Context) {
+	var input models.AvailabilityBlackoutInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	blackout, err := h.availabilityService.AddBlackout(c.Request.Context(), &input, userID)
+	if err != nil {
+		respondAvailabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, blackout)
+}
+
+func (h *AvailabilityHandler) UpdateBlackout(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid blackout ID format"})
+		return
+	}
+
+	var input models.AvailabilityBlackoutInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	blackout, err := h.availabilityService.UpdateBlackout(c.Request.Context(), id, &input, userID)
+	if err != nil {
+		respondAvailabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, blackout)
+}
+
+func (h *AvailabilityHandler) DeleteBlackout(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid blackout ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	if err := h.availabilityService.DeleteBlackout(c.Request.Context(), id, userID); err != nil {
+		respondAvailabilityError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListAvailable lists volunteers free for a shift given as RFC 3339 start and
+// end times, optionally filtered by comma-separated skills and a location.
+func (h *AvailabilityHandler) ListAvailable(c *gin.Context) {
+	start, err := time.Parse(time.RFC3339, c.Query("start"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid start. Use RFC 3339, e.g. 2024-06-01T09:00:00Z"})
+		return
+	}
+	end, err := time.Parse(time.RFC3339, c.Query("end"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid end. Use RFC 3339, e.g. 2024-06-01T13:00:00Z"})
+		return
+	}
+	offset, limit := getPagination(c)
+
+	volunteers, err := h.availabilityService.ListAvailable(c.Request.Context(), start, end, splitSkills(c.Query("skills")), c.Query("location"), offset, limit)
+	if err != nil {
+		respondAvailabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, volunteers)
+}
+
+func (h *AvailabilityHandler) ListAvailableForEvent(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+	offset, limit := getPagination(c)
+	userID := c.GetInt64("userID")
+
+	volunteers, err := h.availabilityService.ListAvailableForEvent(c.Request.Context(), eventID, splitSkills(c.Query("skills")), offset, limit, userID)
+	if err != nil {
+		respondAvailabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, volunteers)
+}
+
+func splitSkills(value string) []string {
+	var skills []string
+	for _, skill := range strings.Split(value, ",") {
+		if skill = strings.TrimSpace(skill); skill != "" {
+			skills = append(skills, skill)
+		}
+	}
+	return skills
+}
+
+func respondAvailabilityError(c *gin.Context, err error) {
+	switch err {
+	case service.ErrVolunteerNotFound, service.ErrEventNotFound,
+		service.ErrAvailabilityWindowNotFound, service.ErrAvailabilityBlackoutNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case service.ErrInvalidTimeZone, service.ErrInvalidAvailabilityWindow,
+		service.ErrInvalidBlackout, service.ErrInvalidShift:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case service.ErrAvailabilityWindowOverlap:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case service.ErrUnauthorized:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
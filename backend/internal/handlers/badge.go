@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BadgeHandler struct {
+	badgeService *service.BadgeService
+}
+
+func NewBadgeHandler(badgeService *service.BadgeService) *BadgeHandler {
+	return &BadgeHandler{
+		badgeService: badgeService,
+	}
+}
+
+// List returns the active badges, or every badge to an admin asking for
+// inactive ones too.
+func (h *BadgeHandler) List(c *gin.Context) {
+	activeOnly := !(c.GetString("userRole") == string(models.RoleAdmin) && getBoolParam(c, "include_inactive", false))
+
+	badges, err := h.badgeService.List(c.Request.Context(), activeOnly)
+	if err != nil {
+		respondBadgeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, badges)
+}
+
+func (h *BadgeHandler) Create(c *gin.Context) {
+	var input models.BadgeInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	badge, err := h.badgeService.Create(c.Request.Context(), &input, userID)
+	if err != nil {
+		respondBadgeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, badge)
+}
+
+func (h *BadgeHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid badge ID format"})
+		return
+	}
+
+	var input models.BadgeInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	badge, err := h.badgeService.Update(c.Request.Context(), id, &input)
+	if err != nil {
+		respondBadgeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, badge)
+}
+
+func (h *BadgeHandler) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid badge ID format"})
+		return
+	}
+
+	if err := h.badgeService.Delete(c.Request.Context(), id); err != nil {
+		respondBadgeError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *BadgeHandler) GetMyProgress(c *gin.Context) {
+	userID := c.GetInt64("userID")
+
+	progress, err := h.badgeService.GetMyProgress(c.Request.Context(), userID)
+	if err != nil {
+		respondBadgeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, progress)
+}
+
+func (h *BadgeHandler) ListAchievements(c *gin.Context) {
+	volunteerID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid volunteer ID format"})
+		return
+	}
+
+	achievements, err := h.badgeService.ListAchievements(c.Request.Context(), volunteerID)
+	if err != nil {
+		respondBadgeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, achievements)
+}
+
+func respondBadgeError(c *gin.Context, err error) {
+	var validationErr *service.FormValidationError
+	if errors.As(err, &validationErr) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid badge", "fields": validationErr.Fields})
+		return
+	}
+
+	switch err {
+	case service.ErrBadgeNotFound, service.ErrVolunteerNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"volunteer-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CertificateHandler struct {
+	certificateService *service.CertificateService
+}
+
+func NewCertificateHandler(certificateService *service.CertificateService) *CertificateHandler {
+	return &CertificateHandler{
+		certificateService: certificateService,
+	}
+}
+
+// Transcript issues the volunteer a signed PDF transcript of their approved
+// hours. The verification code is also sent in the X-Verification-Code header.
+func (h *CertificateHandler) Transcript(c *gin.Context) {
+	userID := c.GetInt64("userID")
+
+	content, document, err := h.certificateService.Transcript(c.Request.Context(), userID)
+	if err != nil {
+		respondCertificateError(c, err)
+		return
+	}
+
+	c.Header("X-Verification-Code", document.Code)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=transcript-%s.pdf", document.Code))
+	c.Data(http.StatusCreated, "application/pdf", content)
+}
+
+// Certificate issues a signed PDF certificate for a volunteer's hours at one
+// of the organization's events.
+func (h *CertificateHandler) Certificate(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+	volunteerID, err := strconv.ParseInt(c.Param("volunteer_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid volunteer ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	content, document, err := h.certificateService.Certificate(c.Request.Context(), eventID, volunteerID, userID)
+	if err != nil {
+		respondCertificateError(c, err)
+		return
+	}
+
+	c.Header("X-Verification-Code", document.Code)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=certificate-%s.pdf", document.Code))
+	c.Data(http.StatusCreated, "application/pdf", content)
+}
+
+// Verify confirms a transcript or certificate from its verification code. It
+// is public so schools and scholarship committees can check documents.
+func (h *CertificateHandler) Verify(c *gin.Context) {
+	verification, err := h.certificateService.Verify(c.Request.Context(), c.Param("code"))
+	if err != nil {
+		respondCertificateError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, verification)
+}
+
+func respondCertificateError(c *gin.Context, err error) {
+	switch err {
+	case service.ErrVolunteerNotFound, service.ErrEventNotFound, service.ErrIssuedDocumentNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case service.ErrNoApprovedHours:
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	case service.ErrUnauthorized:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EmergencyInfoHandler struct {
+	emergencyService *service.EmergencyInfoService
+}
+
+func NewEmergencyInfoHandler(emergencyService *service.EmergencyInfoService) *EmergencyInfoHandler {
+	return &EmergencyInfoHandler{
+		emergencyService: emergencyService,
+	}
+}
+
+func (h *EmergencyInfoHandler) GetMine(c *gin.Context) {
+	userID := c.GetInt64("userID")
+
+	info, err := h.emergencyService.GetMine(c.Request.Context(), userID)
+	if err != nil {
+		respondEmergencyInfoError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, info)
+}
+
+func (h *EmergencyInfoHandler) SaveMine(c *gin.Context) {
+	var input models.EmergencyInfo
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	info, err := h.emergencyService.SaveMine(c.Request.Context(), userID, &input)
+	if err != nil {
+		respondEmergencyInfoError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, info)
+}
+
+func (h *EmergencyInfoHandler) DeleteMine(c *gin.Context) {
+	userID := c.GetInt64("userID")
+
+	if err := h.emergencyService.DeleteMine(c.Request.Context(), userID); err != nil {
+		respondEmergencyInfoError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *EmergencyInfoHandler) ListMyAccesses(c *gin.Context) {
+	offset, limit := getPagination(c)
+	userID := c.GetInt64("userID")
+
+	accesses, err := h.emergencyService.ListMyAccesses(c.Request.Context(), userID, offset, limit)
+	if err != nil {
+		respondEmergencyInfoError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, accesses)
+}
+
+func (h *EmergencyInfoHandler) ListForEvent(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+	userID := c.GetInt64("userID")
+
+	infos, err := h.emergencyService.ListForEvent(c.Request.Context(), eventID, userID, c.ClientIP())
+	if err != nil {
+		respondEmergencyInfoError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, infos)
+}
+
+func (h *EmergencyInfoHandler) GetForEventVolunteer(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+	volunteerID, err := strconv.ParseInt(c.Param("volunteer_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid volunteer ID format"})
+		return
+	}
+	userID := c.GetInt64("userID")
+
+	info, err := h.emergencyService.GetForEventVolunteer(c.Request.Context(), eventID, volunteerID, userID, c.ClientIP())
+	if err != nil {
+		respondEmergencyInfoError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, info)
+}
+
+func respondEmergencyInfoError(c *gin.Context, err error) {
+	switch err {
+	case service.ErrVolunteerNotFound, service.ErrEventNotFound, service.ErrRegistrationNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case service.ErrEmergencyInfoClosed, service.ErrUnauthorized:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case service.ErrEmergencyInfoUnavailable:
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
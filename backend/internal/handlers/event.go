@@ -1,10 +1,10 @@
 package handlers
 
 import (
+	"io"
 	"net/http"
 	"strconv"
 	"strings"
-	"time"
 	"volunteer-management/internal/models"
 	"volunteer-management/internal/service"
 	"volunteer-management/internal/utils"
@@ -29,12 +29,20 @@ func (h *EventHandler) Create(c *gin.Context) {
 		return
 	}
 
-	// Get user ID from context (set by auth middleware)
+	// Get user ID from context (set by auth middleware); the event is hosted
+	// by the user's organization
 	userID := c.GetInt64("userID")
 
 	event, err := h.eventService.Create(c.Request.Context(), &input, userID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		switch err {
+		case service.ErrInvalidTimeZone:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case service.ErrUnauthorized:
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
 		return
 	}
 
@@ -48,7 +56,9 @@ func (h *EventHandler) GetByID(c *gin.Context) {
 		return
 	}
 
-	event, err := h.eventService.GetByID(c.Request.Context(), id)
+	userID := c.GetInt64("userID")
+
+	event, err := h.eventService.GetVisible(c.Request.Context(), id, userID, c.GetString("userRole"))
 	if err != nil {
 		switch err {
 		case service.ErrEventNotFound:
@@ -84,6 +94,10 @@ func (h *EventHandler) Update(c *gin.Context) {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 		case service.ErrUnauthorized:
 			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case service.ErrInvalidTimeZone:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case service.ErrEventNotCompletable:
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
 		}
@@ -108,6 +122,8 @@ func (h *EventHandler) Delete(c *gin.Context) {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 		case service.ErrUnauthorized:
 			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case service.ErrEventHasRegistrations:
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
 		}
@@ -117,6 +133,85 @@ func (h *EventHandler) Delete(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+func (h *EventHandler) Cancel(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	// The organizer message is optional, so an empty body is accepted
+	var input models.CancelEventInput
+	if err := c.ShouldBindJSON(&input); err != nil && err != io.EOF {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	event, err := h.eventService.Cancel(c.Request.Context(), id, &input, userID)
+	if err != nil {
+		switch err {
+		case service.ErrEventNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case service.ErrUnauthorized:
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case service.ErrEventAlreadyCancelled:
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, event)
+}
+
+func (h *EventHandler) ListPendingReview(c *gin.Context) {
+	offset, limit := getPagination(c)
+
+	events, err := h.eventService.ListPendingReview(c.Request.Context(), offset, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch review queue"})
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
+
+func (h *EventHandler) Review(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var input models.ReviewEventInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	event, err := h.eventService.Review(c.Request.Context(), id, &input, userID)
+	if err != nil {
+		switch err {
+		case service.ErrEventNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case service.ErrReviewReasonRequired:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case service.ErrEventNotPendingReview:
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, event)
+}
+
 func (h *EventHandler) List(c *gin.Context) {
 	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
@@ -132,7 +227,6 @@ func (h *EventHandler) List(c *gin.Context) {
 
 func (h *EventHandler) Search(c *gin.Context) {
 	// Parse query parameters
-	dateStr := c.Query("date")
 	location := c.Query("location")
 	skillsStr := c.Query("skills")
 	status := c.Query("status")
@@ -140,15 +234,22 @@ func (h *EventHandler) Search(c *gin.Context) {
 	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 
-	// Parse date if provided
-	var date time.Time
-	if dateStr != "" {
-		var err error
-		date, err = time.Parse("2006-01-02", dateStr)
-		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format. Use YYYY-MM-DD"})
-			return
-		}
+	// A single date is shorthand for a range covering that day. Dates are
+	// matched against the local day of each event.
+	fromStr, toStr := c.Query("from"), c.Query("to")
+	if dateStr := c.Query("date"); dateStr != "" {
+		fromStr, toStr = dateStr, dateStr
+	}
+
+	from, err := parseDateQuery(fromStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format. Use YYYY-MM-DD"})
+		return
+	}
+	to, err := parseDateQuery(toStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format. Use YYYY-MM-DD"})
+		return
 	}
 
 	// Parse skills if provided
@@ -162,7 +263,7 @@ func (h *EventHandler) Search(c *gin.Context) {
 	offset = utils.ValidateOffset(offset)
 
 	// Perform search
-	events, err := h.eventService.Search(c.Request.Context(), date, location, skills, status, category, offset, limit)
+	events, err := h.eventService.Search(c.Request.Context(), from, to, location, skills, status, category, offset, limit)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to search events"})
 		return
@@ -186,6 +287,7 @@ func (h *EventHandler) RegisterRoutes(router *gin.Engine, authMiddleware gin.Han
 			authorized.POST("", h.Create)
 			authorized.PUT("/:id", h.Update)
 			authorized.DELETE("/:id", h.Delete)
+			authorized.POST("/:id/cancel", h.Cancel)
 		}
 	}
 }
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EventCohostHandler struct {
+	cohostService *service.EventCohostService
+}
+
+func NewEventCohostHandler(cohostService *service.EventCohostService) *EventCohostHandler {
+	return &EventCohostHandler{
+		cohostService: cohostService,
+	}
+}
+
+func (h *EventCohostHandler) List(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+
+	cohosts, err := h.cohostService.ListByEvent(c.Request.Context(), eventID)
+	if err != nil {
+		respondCohostError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, cohosts)
+}
+
+func (h *EventCohostHandler) ListInvitations(c *gin.Context) {
+	userID := c.GetInt64("userID")
+
+	invitations, err := h.cohostService.ListInvitations(c.Request.Context(), userID)
+	if err != nil {
+		respondCohostError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, invitations)
+}
+
+func (h *EventCohostHandler) Invite(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+
+	var input models.InviteCohostInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	cohost, err := h.cohostService.Invite(c.Request.Context(), eventID, &input, userID)
+	if err != nil {
+		respondCohostError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, cohost)
+}
+
+func (h *EventCohostHandler) Respond(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+
+	var input models.RespondCohostInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	cohost, err := h.cohostService.Respond(c.Request.Context(), eventID, &input, userID)
+	if err != nil {
+		respondCohostError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, cohost)
+}
+
+func (h *EventCohostHandler) Remove(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+	organizationID, err := strconv.ParseInt(c.Param("org_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid organization ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	if err := h.cohostService.Remove(c.Request.Context(), eventID, organizationID, userID); err != nil {
+		respondCohostError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Co-host removed"})
+}
+
+func respondCohostError(c *gin.Context, err error) {
+	switch err {
+	case service.ErrEventNotFound, service.ErrOrganizationNotFound, service.ErrCohostInviteNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case service.ErrUnauthorized:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case service.ErrCannotCohostOwnEvent:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case service.ErrAlreadyCohost:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
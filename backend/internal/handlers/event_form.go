@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EventFormHandler struct {
+	formService *service.EventFormService
+}
+
+func NewEventFormHandler(formService *service.EventFormService) *EventFormHandler {
+	return &EventFormHandler{
+		formService: formService,
+	}
+}
+
+func (h *EventFormHandler) GetCurrent(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+
+	form, err := h.formService.GetCurrent(c.Request.Context(), eventID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		return
+	}
+	if form == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": service.ErrEventFormNotFound.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, form)
+}
+
+func (h *EventFormHandler) ListVersions(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	forms, err := h.formService.ListVersions(c.Request.Context(), eventID, userID)
+	if err != nil {
+		respondFormError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, forms)
+}
+
+func (h *EventFormHandler) Save(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+
+	var input models.SaveEventFormInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	form, err := h.formService.Save(c.Request.Context(), eventID, &input, userID)
+	if err != nil {
+		respondFormError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, form)
+}
+
+// ExportAnswers returns every answer for the event as JSON, or as a CSV file
+// when called with ?format=csv.
+func (h *EventFormHandler) ExportAnswers(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	rows, err := h.formService.ExportAnswers(c.Request.Context(), eventID, userID)
+	if err != nil {
+		respondFormError(c, err)
+		return
+	}
+
+	if c.Query("format") != "csv" {
+		c.JSON(http.StatusOK, rows)
+		return
+	}
+
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=event-%d-answers.csv", eventID))
+	c.Status(http.StatusOK)
+
+	w := csv.NewWriter(c.Writer)
+	w.Write([]string{"registration_id", "volunteer_id", "status", "form_version", "question_id", "question", "answer"})
+	for _, row := range rows {
+		w.Write([]string{
+			strconv.FormatInt(row.RegistrationID, 10),
+			strconv.FormatInt(row.VolunteerID, 10),
+			row.Status,
+			strconv.Itoa(row.FormVersion),
+			csvCell(row.QuestionID),
+			csvCell(row.QuestionLabel),
+			csvCell(row.Answer),
+		})
+	}
+	w.Flush()
+}
+
+func respondFormError(c *gin.Context, err error) {
+	var validationErr *service.FormValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid form", "fields": validationErr.Fields})
+	case err == service.ErrEventNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case err == service.ErrUnauthorized:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
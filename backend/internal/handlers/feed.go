@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type FeedHandler struct {
+	feedService *service.FeedService
+}
+
+func NewFeedHandler(feedService *service.FeedService) *FeedHandler {
+	return &FeedHandler{
+		feedService: feedService,
+	}
+}
+
+// Feed returns the volunteer's activity feed. Pass ?cursor= with the
+// previous page's next_cursor to page through it.
+func (h *FeedHandler) Feed(c *gin.Context) {
+	limit := getLimitParam(c, DefaultLimit)
+	userID := c.GetInt64("userID")
+
+	page, err := h.feedService.Feed(c.Request.Context(), userID, c.Query("cursor"), limit)
+	if err != nil {
+		respondFeedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+func (h *FeedHandler) Follow(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid organization ID format"})
+		return
+	}
+
+	// The body is optional; without it the follower stays private
+	var input models.FollowOrganizationInput
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	userID := c.GetInt64("userID")
+
+	follow, err := h.feedService.Follow(c.Request.Context(), id, &input, userID)
+	if err != nil {
+		respondFeedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, follow)
+}
+
+func (h *FeedHandler) Unfollow(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid organization ID format"})
+		return
+	}
+	userID := c.GetInt64("userID")
+
+	if err := h.feedService.Unfollow(c.Request.Context(), id, userID); err != nil {
+		respondFeedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *FeedHandler) ListFollowing(c *gin.Context) {
+	userID := c.GetInt64("userID")
+
+	follows, err := h.feedService.ListFollowing(c.Request.Context(), userID)
+	if err != nil {
+		respondFeedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, follows)
+}
+
+func (h *FeedHandler) ListFollowers(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid organization ID format"})
+		return
+	}
+	offset, limit := getPagination(c)
+	userID := c.GetInt64("userID")
+
+	followers, err := h.feedService.ListFollowers(c.Request.Context(), id, userID, c.GetString("userRole"), offset, limit)
+	if err != nil {
+		respondFeedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, followers)
+}
+
+// CreateAnnouncement posts to an organization's followers under
+// /organizations/:id/announcements, or to everyone under /announcements.
+func (h *FeedHandler) CreateAnnouncement(c *gin.Context) {
+	var organizationID *int64
+	if idStr := c.Param("id"); idStr != "" {
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid organization ID format"})
+			return
+		}
+		organizationID = &id
+	}
+
+	var input models.AnnouncementInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	announcement, err := h.feedService.CreateAnnouncement(c.Request.Context(), organizationID, &input, userID, c.GetString("userRole"))
+	if err != nil {
+		respondFeedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, announcement)
+}
+
+func (h *FeedHandler) ListAnnouncements(c *gin.Context) {
+	var organizationID *int64
+	if idStr := c.Param("id"); idStr != "" {
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid organization ID format"})
+			return
+		}
+		organizationID = &id
+	}
+	offset, limit := getPagination(c)
+
+	announcements, err := h.feedService.ListAnnouncements(c.Request.Context(), organizationID, offset, limit)
+	if err != nil {
+		respondFeedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, announcements)
+}
+
+func (h *FeedHandler) DeleteAnnouncement(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid announcement ID format"})
+		return
+	}
+	userID := c.GetInt64("userID")
+
+	if err := h.feedService.DeleteAnnouncement(c.Request.Context(), id, userID, c.GetString("userRole")); err != nil {
+		respondFeedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func respondFeedError(c *gin.Context, err error) {
+	switch err {
+	case service.ErrVolunteerNotFound, service.ErrOrganizationNotFound, service.ErrAnnouncementNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case service.ErrInvalidFeedCursor:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case service.ErrUnauthorized:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
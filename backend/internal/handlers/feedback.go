@@ -0,0 +1,224 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type FeedbackHandler struct {
+	feedbackService *service.FeedbackService
+}
+
+func NewFeedbackHandler(feedbackService *service.FeedbackService) *FeedbackHandler {
+	return &FeedbackHandler{
+		feedbackService: feedbackService,
+	}
+}
+
+func (h *FeedbackHandler) Submit(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+
+	var input models.SubmitFeedbackInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	feedback, err := h.feedbackService.Submit(c.Request.Context(), eventID, &input, userID)
+	if err != nil {
+		respondFeedbackError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, feedback)
+}
+
+func (h *FeedbackHandler) GetMine(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	feedback, err := h.feedbackService.GetMine(c.Request.Context(), eventID, userID)
+	if err != nil {
+		respondFeedbackError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, feedback)
+}
+
+func (h *FeedbackHandler) ListByEvent(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+
+	offset, limit := getPagination(c)
+
+	feedback, err := h.feedbackService.ListByEvent(c.Request.Context(), eventID, c.GetString("userRole"), offset, limit)
+	if err != nil {
+		respondFeedbackError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, feedback)
+}
+
+func (h *FeedbackHandler) Report(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feedback ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	if err := h.feedbackService.Report(c.Request.Context(), id, userID); err != nil {
+		respondFeedbackError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Feedback reported"})
+}
+
+func (h *FeedbackHandler) ListModerationQueue(c *gin.Context) {
+	offset, limit := getPagination(c)
+
+	feedback, err := h.feedbackService.ListModerationQueue(c.Request.Context(), offset, limit)
+	if err != nil {
+		respondFeedbackError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, feedback)
+}
+
+func (h *FeedbackHandler) Moderate(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feedback ID format"})
+		return
+	}
+
+	var input models.ModerateFeedbackInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	adminID := c.GetInt64("userID")
+
+	feedback, err := h.feedbackService.Moderate(c.Request.Context(), id, &input, adminID)
+	if err != nil {
+		respondFeedbackError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, feedback)
+}
+
+func (h *FeedbackHandler) RateVolunteer(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+	volunteerID, err := strconv.ParseInt(c.Param("volunteer_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid volunteer ID format"})
+		return
+	}
+
+	var input models.RateVolunteerInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	rating, err := h.feedbackService.RateVolunteer(c.Request.Context(), eventID, volunteerID, &input, userID)
+	if err != nil {
+		respondFeedbackError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, rating)
+}
+
+func (h *FeedbackHandler) GetVolunteerRatings(c *gin.Context) {
+	volunteerID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid volunteer ID format"})
+		return
+	}
+
+	summary, err := h.feedbackService.GetVolunteerRatings(c.Request.Context(), volunteerID)
+	if err != nil {
+		respondFeedbackError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
+
+func (h *FeedbackHandler) GetReport(c *gin.Context) {
+	orgID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid organization ID"})
+		return
+	}
+
+	from, err := parseDateQuery(c.Query("from"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format. Use YYYY-MM-DD"})
+		return
+	}
+	to, err := parseDateQuery(c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format. Use YYYY-MM-DD"})
+		return
+	}
+	// The filter's upper bound is exclusive; include the whole "to" day
+	if !to.IsZero() {
+		to = to.AddDate(0, 0, 1)
+	}
+
+	userID := c.GetInt64("userID")
+
+	report, err := h.feedbackService.GetReport(c.Request.Context(), orgID, models.FeedbackReportFilter{From: from, To: to}, userID, c.GetString("userRole"))
+	if err != nil {
+		respondFeedbackError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+func respondFeedbackError(c *gin.Context, err error) {
+	switch err {
+	case service.ErrEventNotFound, service.ErrFeedbackNotFound, service.ErrVolunteerNotFound, service.ErrOrganizationNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case service.ErrUnauthorized, service.ErrNotEventParticipant:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case service.ErrEventNotComplete, service.ErrFeedbackWindowClosed, service.ErrCannotReportOwn:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
@@ -0,0 +1,352 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GroupHandler struct {
+	groupService *service.GroupService
+}
+
+func NewGroupHandler(groupService *service.GroupService) *GroupHandler {
+	return &GroupHandler{
+		groupService: groupService,
+	}
+}
+
+func (h *GroupHandler) Create(c *gin.Context) {
+	var input models.CreateGroupInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	group, err := h.groupService.Create(c.Request.Context(), &input, userID)
+	if err != nil {
+		respondGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, group)
+}
+
+func (h *GroupHandler) ListMine(c *gin.Context) {
+	userID := c.GetInt64("userID")
+
+	groups, err := h.groupService.ListMine(c.Request.Context(), userID)
+	if err != nil {
+		respondGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, groups)
+}
+
+func (h *GroupHandler) GetByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid group ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	group, err := h.groupService.GetByID(c.Request.Context(), id, userID, c.GetString("userRole"))
+	if err != nil {
+		respondGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, group)
+}
+
+func (h *GroupHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid group ID format"})
+		return
+	}
+
+	var input models.UpdateGroupInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	group, err := h.groupService.Update(c.Request.Context(), id, &input, userID)
+	if err != nil {
+		respondGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, group)
+}
+
+func (h *GroupHandler) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid group ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	if err := h.groupService.Delete(c.Request.Context(), id, userID); err != nil {
+		respondGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Group deleted"})
+}
+
+func (h *GroupHandler) Invite(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid group ID format"})
+		return
+	}
+
+	var input models.InviteGroupMembersInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	invitations, err := h.groupService.Invite(c.Request.Context(), id, &input, userID)
+	if err != nil {
+		respondGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, invitations)
+}
+
+func (h *GroupHandler) ListInvitations(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid group ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	invitations, err := h.groupService.ListInvitations(c.Request.Context(), id, userID)
+	if err != nil {
+		respondGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, invitations)
+}
+
+func (h *GroupHandler) RevokeInvitation(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid group ID format"})
+		return
+	}
+	invitationID, err := strconv.ParseInt(c.Param("invitation_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invitation ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	if err := h.groupService.RevokeInvitation(c.Request.Context(), id, invitationID, userID); err != nil {
+		respondGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Invitation revoked"})
+}
+
+func (h *GroupHandler) AcceptInvitation(c *gin.Context) {
+	var input models.AcceptGroupInvitationInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	group, err := h.groupService.AcceptInvitation(c.Request.Context(), &input, userID)
+	if err != nil {
+		respondGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, group)
+}
+
+func (h *GroupHandler) SetMemberRole(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid group ID format"})
+		return
+	}
+	volunteerID, err := strconv.ParseInt(c.Param("volunteer_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid volunteer ID format"})
+		return
+	}
+
+	var input models.UpdateGroupMemberInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	if err := h.groupService.SetMemberRole(c.Request.Context(), id, volunteerID, &input, userID); err != nil {
+		respondGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Member updated"})
+}
+
+func (h *GroupHandler) RemoveMember(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid group ID format"})
+		return
+	}
+	volunteerID, err := strconv.ParseInt(c.Param("volunteer_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid volunteer ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	if err := h.groupService.RemoveMember(c.Request.Context(), id, volunteerID, userID); err != nil {
+		respondGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Member removed"})
+}
+
+func (h *GroupHandler) SignUp(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid group ID format"})
+		return
+	}
+
+	var input models.CreateGroupSignupInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	groupSignup, err := h.groupService.SignUp(c.Request.Context(), id, &input, userID)
+	if err != nil {
+		respondGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, groupSignup)
+}
+
+func (h *GroupHandler) ListSignups(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid group ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	groupSignups, err := h.groupService.ListSignups(c.Request.Context(), id, userID)
+	if err != nil {
+		respondGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, groupSignups)
+}
+
+func (h *GroupHandler) GetSignup(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid group signup ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	groupSignup, err := h.groupService.GetSignup(c.Request.Context(), id, userID, c.GetString("userRole"))
+	if err != nil {
+		respondGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, groupSignup)
+}
+
+func (h *GroupHandler) RecordAttendance(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid group signup ID format"})
+		return
+	}
+
+	var input models.RecordGroupAttendanceInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	groupSignup, err := h.groupService.RecordAttendance(c.Request.Context(), id, &input, userID)
+	if err != nil {
+		respondGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, groupSignup)
+}
+
+func respondGroupError(c *gin.Context, err error) {
+	var validationErr *service.FormValidationError
+	var capacityErr *service.GroupCapacityError
+	var ineligibleErr *service.GroupSignupIneligibleError
+	switch {
+	case errors.As(err, &validationErr):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid members", "fields": validationErr.Fields})
+	case errors.As(err, &capacityErr):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error(), "seats_left": capacityErr.SeatsLeft})
+	case errors.As(err, &ineligibleErr):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error(), "members": ineligibleErr.Members})
+	case err == service.ErrEventNotOpen:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case err == service.ErrGroupNotFound, err == service.ErrGroupMemberNotFound, err == service.ErrGroupInvitationNotFound,
+		err == service.ErrGroupSignupNotFound, err == service.ErrEventNotFound, err == service.ErrVolunteerNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case err == service.ErrUnauthorized, err == service.ErrNotGroupLeader:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case err == service.ErrLastGroupLeader, err == service.ErrGroupInvitationInvalid, err == service.ErrGroupSignupFormRequired,
+		err == service.ErrGroupSignupNoMembers, err == service.ErrInvalidEventHost:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
@@ -8,32 +8,93 @@ import (
 type Handlers struct {
 	Auth         *AuthHandler
 	Event        *EventHandler
+	EventForm    *EventFormHandler
+	EventCohost  *EventCohostHandler
+	Feedback     *FeedbackHandler
+	Attendance   *AttendanceHandler
 	Signup       *SignupHandler
+	Group        *GroupHandler
+	Hours        *HoursHandler
+	Certificate  *CertificateHandler
+	School       *SchoolHandler
 	Organization *OrganizationHandler
+	Feed         *FeedHandler
 	Volunteer    *VolunteerHandler
+	Reliability  *ReliabilityHandler
+	Availability *AvailabilityHandler
+	Verification *VerificationHandler
+	Emergency    *EmergencyInfoHandler
+	Badge        *BadgeHandler
+	Progression  *ProgressionHandler
 	Message      *MessageHandler
 	Analytics    *AnalyticsHandler
+	ImpactMetric *ImpactMetricHandler
+	Notification *NotificationHandler
+	Recommend    *RecommendationHandler
+	Waiver       *WaiverHandler
+	Media        *MediaHandler
 	WebSocket    *WebSocketHandler
 }
 
 func NewHandlers(
 	authService *service.AuthService,
 	eventService *service.EventService,
-	signupService *service.SignupService,
+	eventFormService *service.EventFormService,
+	eventCohostService *service.EventCohostService,
+	feedbackService *service.FeedbackService,
+	attendanceService *service.AttendanceService,
+	registrationService *service.RegistrationService,
+	groupService *service.GroupService,
+	hoursService *service.HoursService,
+	certificateService *service.CertificateService,
+	schoolService *service.SchoolService,
 	organizationService *service.OrganizationService,
+	feedService *service.FeedService,
 	volunteerService *service.VolunteerService,
+	reliabilityService *service.ReliabilityService,
+	availabilityService *service.AvailabilityService,
+	verificationService *service.VerificationService,
+	emergencyInfoService *service.EmergencyInfoService,
+	badgeService *service.BadgeService,
+	progressionService *service.ProgressionService,
 	messageService *service.MessageService,
 	analyticsService *service.AnalyticsService,
+	impactMetricService *service.ImpactMetricService,
+	notificationService *service.NotificationService,
+	recommendationService *service.RecommendationService,
+	waiverService *service.WaiverService,
+	mediaService *service.MediaService,
+	maxUploadSize int64,
 	wsManager *websocket.Manager,
 ) *Handlers {
 	return &Handlers{
 		Auth:         NewAuthHandler(authService),
 		Event:        NewEventHandler(eventService),
-		Signup:       NewSignupHandler(signupService),
+		EventForm:    NewEventFormHandler(eventFormService),
+		EventCohost:  NewEventCohostHandler(eventCohostService),
+		Feedback:     NewFeedbackHandler(feedbackService),
+		Attendance:   NewAttendanceHandler(attendanceService),
+		Signup:       NewSignupHandler(registrationService),
+		Group:        NewGroupHandler(groupService),
+		Hours:        NewHoursHandler(hoursService),
+		Certificate:  NewCertificateHandler(certificateService),
+		School:       NewSchoolHandler(schoolService),
 		Organization: NewOrganizationHandler(organizationService),
+		Feed:         NewFeedHandler(feedService),
 		Volunteer:    NewVolunteerHandler(volunteerService),
+		Reliability:  NewReliabilityHandler(reliabilityService),
+		Availability: NewAvailabilityHandler(availabilityService),
+		Verification: NewVerificationHandler(verificationService, maxUploadSize),
+		Emergency:    NewEmergencyInfoHandler(emergencyInfoService),
+		Badge:        NewBadgeHandler(badgeService),
+		Progression:  NewProgressionHandler(progressionService),
 		Message:      NewMessageHandler(messageService),
 		Analytics:    NewAnalyticsHandler(analyticsService),
+		ImpactMetric: NewImpactMetricHandler(impactMetricService),
+		Notification: NewNotificationHandler(notificationService),
+		Recommend:    NewRecommendationHandler(recommendationService),
+		Waiver:       NewWaiverHandler(waiverService),
+		Media:        NewMediaHandler(mediaService, maxUploadSize),
 		WebSocket:    NewWebSocketHandler(wsManager),
 	}
 }
@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HoursHandler struct {
+	hoursService *service.HoursService
+}
+
+func NewHoursHandler(hoursService *service.HoursService) *HoursHandler {
+	return &HoursHandler{
+		hoursService: hoursService,
+	}
+}
+
+func (h *HoursHandler) Submit(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+
+	var input models.SubmitHoursInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	submission, err := h.hoursService.Submit(c.Request.Context(), eventID, &input, userID)
+	if err != nil {
+		respondHoursError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, submission)
+}
+
+func (h *HoursHandler) ListByEvent(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+	offset, limit := getPagination(c)
+
+	submissions, err := h.hoursService.ListByEvent(c.Request.Context(), eventID, userID, offset, limit)
+	if err != nil {
+		respondHoursError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, submissions)
+}
+
+func (h *HoursHandler) ListMine(c *gin.Context) {
+	userID := c.GetInt64("userID")
+	offset, limit := getPagination(c)
+
+	submissions, err := h.hoursService.ListMine(c.Request.Context(), userID, offset, limit)
+	if err != nil {
+		respondHoursError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, submissions)
+}
+
+func (h *HoursHandler) ListReviewQueue(c *gin.Context) {
+	userID := c.GetInt64("userID")
+	offset, limit := getPagination(c)
+
+	submissions, err := h.hoursService.ListReviewQueue(c.Request.Context(), userID, offset, limit)
+	if err != nil {
+		respondHoursError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, submissions)
+}
+
+func (h *HoursHandler) GetByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid hours submission ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	submission, err := h.hoursService.GetByID(c.Request.Context(), id, userID, c.GetString("userRole"))
+	if err != nil {
+		respondHoursError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, submission)
+}
+
+func (h *HoursHandler) Review(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid hours submission ID format"})
+		return
+	}
+
+	var input models.ReviewHoursInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	submission, err := h.hoursService.Review(c.Request.Context(), id, &input, userID)
+	if err != nil {
+		respondHoursError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, submission)
+}
+
+func (h *HoursHandler) Dispute(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid hours submission ID format"})
+		return
+	}
+
+	var input models.DisputeHoursInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	submission, err := h.hoursService.Dispute(c.Request.Context(), id, &input, userID)
+	if err != nil {
+		respondHoursError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, submission)
+}
+
+func respondHoursError(c *gin.Context, err error) {
+	var validationErr *service.FormValidationError
+	if errors.As(err, &validationErr) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid review", "fields": validationErr.Fields})
+		return
+	}
+
+	switch err {
+	case service.ErrEventNotFound, service.ErrHoursNotFound, service.ErrVolunteerNotFound, service.ErrOrganizationNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case service.ErrUnauthorized, service.ErrNotEventParticipant:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case service.ErrHoursAlreadyDecided, service.ErrHoursNotReviewable, service.ErrHoursNotDisputable:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case service.ErrHoursBeforeEvent, service.ErrHoursExceedEvent:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
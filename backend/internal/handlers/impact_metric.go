@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ImpactMetricHandler struct {
+	metricService *service.ImpactMetricService
+}
+
+func NewImpactMetricHandler(metricService *service.ImpactMetricService) *ImpactMetricHandler {
+	return &ImpactMetricHandler{
+		metricService: metricService,
+	}
+}
+
+func (h *ImpactMetricHandler) List(c *gin.Context) {
+	category := c.Query("category")
+	includeInactive := c.GetString("userRole") == "admin" && getBoolParam(c, "include_inactive", false)
+
+	metrics, err := h.metricService.List(c.Request.Context(), category, includeInactive)
+	if err != nil {
+		respondImpactMetricError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, metrics)
+}
+
+func (h *ImpactMetricHandler) Create(c *gin.Context) {
+	var input models.CreateImpactMetricInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	metric, err := h.metricService.Create(c.Request.Context(), &input)
+	if err != nil {
+		respondImpactMetricError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, metric)
+}
+
+func (h *ImpactMetricHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid metric ID format"})
+		return
+	}
+
+	var input models.UpdateImpactMetricInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	metric, err := h.metricService.Update(c.Request.Context(), id, &input)
+	if err != nil {
+		respondImpactMetricError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, metric)
+}
+
+func (h *ImpactMetricHandler) ListEventValues(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+
+	values, err := h.metricService.ListEventValues(c.Request.Context(), eventID)
+	if err != nil {
+		respondImpactMetricError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, values)
+}
+
+func (h *ImpactMetricHandler) RecordEventValues(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+
+	var input models.RecordEventMetricsInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	values, err := h.metricService.RecordEventValues(c.Request.Context(), eventID, &input, userID)
+	if err != nil {
+		respondImpactMetricError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, values)
+}
+
+func respondImpactMetricError(c *gin.Context, err error) {
+	var validationErr *service.FormValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid metric values", "fields": validationErr.Fields})
+	case err == service.ErrInvalidMetricKey:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case err == service.ErrEventNotFound, err == service.ErrImpactMetricNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case err == service.ErrUnauthorized:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case err == service.ErrImpactMetricExists:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
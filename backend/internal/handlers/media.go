@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/service"
+	"volunteer-management/pkg/storage"
+
+	"github.com/gin-gonic/gin"
+)
+
+// multipartOverhead allows for form boundaries and headers around the file.
+const multipartOverhead = 64 * 1024
+
+type MediaHandler struct {
+	mediaService  *service.MediaService
+	maxUploadSize int64
+}
+
+func NewMediaHandler(mediaService *service.MediaService, maxUploadSize int64) *MediaHandler {
+	return &MediaHandler{
+		mediaService:  mediaService,
+		maxUploadSize: maxUploadSize,
+	}
+}
+
+// Upload accepts an image in the multipart field "file".
+func (h *MediaHandler) Upload(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxUploadSize+multipartOverhead)
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		if strings.Contains(err.Error(), "request body too large") {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": service.ErrMediaTooLarge.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "A file is required in the \"file\" field"})
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Could not read uploaded file"})
+		return
+	}
+	defer file.Close()
+
+	userID := c.GetInt64("userID")
+
+	media, err := h.mediaService.Upload(c.Request.Context(), file, userID)
+	if err != nil {
+		switch err {
+		case service.ErrMediaTooLarge, service.ErrImageTooLarge:
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+		case service.ErrUnsupportedMediaType:
+			c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to store upload"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, media)
+}
+
+func (h *MediaHandler) GetByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid media ID format"})
+		return
+	}
+
+	media, err := h.mediaService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		if err == service.ErrMediaNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		return
+	}
+
+	c.JSON(http.StatusOK, media)
+}
+
+// Redirect resolves the stable media path stored on events, organizations and
+// users to a freshly signed URL for a signed-in user. Pass ?variant=thumbnail
+// or medium for resized copies.
+func (h *MediaHandler) Redirect(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid media ID format"})
+		return
+	}
+
+	variant := c.DefaultQuery("variant", models.MediaVariantOriginal)
+
+	url, err := h.mediaService.SignedURL(c.Request.Context(), id, variant)
+	if err != nil {
+		switch err {
+		case service.ErrMediaNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case service.ErrInvalidMediaVariant:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.Redirect(http.StatusFound, url)
+}
+
+// ServeFile serves objects from local storage behind a signed URL.
+func (h *MediaHandler) ServeFile(c *gin.Context) {
+	key := strings.TrimPrefix(c.Param("key"), "/")
+	expires, err := strconv.ParseInt(c.Query("expires"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Invalid or missing signature"})
+		return
+	}
+
+	file, err := h.mediaService.OpenSigned(c.Request.Context(), key, expires, c.Query("signature"))
+	if err != nil {
+		switch err {
+		case storage.ErrBadSignature, storage.ErrURLExpired, storage.ErrInvalidKey:
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case service.ErrMediaNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+	defer file.Close()
+
+	contentType := mime.TypeByExtension(path.Ext(key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	c.Header("Cache-Control", "private, max-age=300")
+	c.DataFromReader(http.StatusOK, -1, contentType, file, nil)
+}
@@ -189,3 +189,72 @@ func (h *OrganizationHandler) VerifyOrganization(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"message": "Organization verified successfully"})
 }
+
+func (h *OrganizationHandler) SetTrusted(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid organization ID"})
+		return
+	}
+
+	var input models.SetOrganizationTrustInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	err = h.organizationService.SetTrusted(c.Request.Context(), id, *input.Trusted, userID.(int64))
+	if err != nil {
+		if err == service.ErrOrganizationNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Organization not found"})
+			return
+		}
+		if err == service.ErrUnauthorized {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Not authorized to change organization trust"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update organization trust"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Organization trust updated successfully"})
+}
+
+func (h *OrganizationHandler) SetSignupPolicy(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid organization ID"})
+		return
+	}
+
+	var input models.SetSignupPolicyInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	organization, err := h.organizationService.SetSignupPolicy(c.Request.Context(), id, &input, userID)
+	if err != nil {
+		if err == service.ErrOrganizationNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Organization not found"})
+			return
+		}
+		if err == service.ErrUnauthorized {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Not authorized to change this organization's signup policy"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update signup policy"})
+		return
+	}
+
+	c.JSON(http.StatusOK, organization)
+}
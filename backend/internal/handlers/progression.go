@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"volunteer-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ProgressionHandler struct {
+	progressionService *service.ProgressionService
+}
+
+func NewProgressionHandler(progressionService *service.ProgressionService) *ProgressionHandler {
+	return &ProgressionHandler{
+		progressionService: progressionService,
+	}
+}
+
+func (h *ProgressionHandler) GetProgress(c *gin.Context) {
+	volunteerID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid volunteer ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	progress, err := h.progressionService.GetProgress(c.Request.Context(), volunteerID, userID, c.GetString("userRole"))
+	if err != nil {
+		respondProgressionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, progress)
+}
+
+func (h *ProgressionHandler) GetMine(c *gin.Context) {
+	userID := c.GetInt64("userID")
+
+	progress, err := h.progressionService.GetMine(c.Request.Context(), userID)
+	if err != nil {
+		respondProgressionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, progress)
+}
+
+// Recompute brings every volunteer's level up to date after the XP rules
+// change.
+func (h *ProgressionHandler) Recompute(c *gin.Context) {
+	result, err := h.progressionService.Recompute(c.Request.Context())
+	if err != nil {
+		respondProgressionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func respondProgressionError(c *gin.Context, err error) {
+	switch err {
+	case service.ErrVolunteerNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case service.ErrUnauthorized:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
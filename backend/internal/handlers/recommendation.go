@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/http"
+	"volunteer-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RecommendationHandler struct {
+	recommendationService *service.RecommendationService
+}
+
+func NewRecommendationHandler(recommendationService *service.RecommendationService) *RecommendationHandler {
+	return &RecommendationHandler{
+		recommendationService: recommendationService,
+	}
+}
+
+func (h *RecommendationHandler) ListRecommendedEvents(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	limit := getLimitParam(c, 10)
+
+	recommendations, err := h.recommendationService.RecommendForUser(c.Request.Context(), userID.(int64), limit)
+	if err != nil {
+		if err == service.ErrVolunteerNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Volunteer profile not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch recommended events"})
+		return
+	}
+
+	c.JSON(http.StatusOK, recommendations)
+}
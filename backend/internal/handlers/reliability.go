@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReliabilityHandler struct {
+	reliabilityService *service.ReliabilityService
+}
+
+func NewReliabilityHandler(reliabilityService *service.ReliabilityService) *ReliabilityHandler {
+	return &ReliabilityHandler{
+		reliabilityService: reliabilityService,
+	}
+}
+
+func (h *ReliabilityHandler) GetMine(c *gin.Context) {
+	userID := c.GetInt64("userID")
+
+	score, err := h.reliabilityService.GetMine(c.Request.Context(), userID)
+	if err != nil {
+		respondReliabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, score)
+}
+
+func (h *ReliabilityHandler) GetForVolunteer(c *gin.Context) {
+	volunteerID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid volunteer ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	score, err := h.reliabilityService.GetForVolunteer(c.Request.Context(), volunteerID, userID, c.GetString("userRole"))
+	if err != nil {
+		respondReliabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, score)
+}
+
+func (h *ReliabilityHandler) Reset(c *gin.Context) {
+	volunteerID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid volunteer ID format"})
+		return
+	}
+
+	var input models.ResetReliabilityInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	score, err := h.reliabilityService.Reset(c.Request.Context(), volunteerID, &input, userID)
+	if err != nil {
+		respondReliabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, score)
+}
+
+func (h *ReliabilityHandler) Appeal(c *gin.Context) {
+	var input models.CreateReliabilityAppealInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	appeal, err := h.reliabilityService.Appeal(c.Request.Context(), &input, userID)
+	if err != nil {
+		respondReliabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, appeal)
+}
+
+func (h *ReliabilityHandler) ListMyAppeals(c *gin.Context) {
+	userID := c.GetInt64("userID")
+
+	appeals, err := h.reliabilityService.ListMyAppeals(c.Request.Context(), userID)
+	if err != nil {
+		respondReliabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, appeals)
+}
+
+func (h *ReliabilityHandler) ListPendingAppeals(c *gin.Context) {
+	offset, limit := getPagination(c)
+
+	appeals, err := h.reliabilityService.ListPendingAppeals(c.Request.Context(), offset, limit)
+	if err != nil {
+		respondReliabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, appeals)
+}
+
+func (h *ReliabilityHandler) ResolveAppeal(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid appeal ID format"})
+		return
+	}
+
+	var input models.ResolveReliabilityAppealInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	appeal, err := h.reliabilityService.ResolveAppeal(c.Request.Context(), id, &input, userID)
+	if err != nil {
+		respondReliabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, appeal)
+}
+
+func respondReliabilityError(c *gin.Context, err error) {
+	switch err {
+	case service.ErrVolunteerNotFound, service.ErrReliabilityAppealNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case service.ErrNothingToAppeal:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case service.ErrReliabilityAppealExists:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case service.ErrUnauthorized:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
@@ -0,0 +1,359 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SchoolHandler struct {
+	schoolService *service.SchoolService
+}
+
+func NewSchoolHandler(schoolService *service.SchoolService) *SchoolHandler {
+	return &SchoolHandler{
+		schoolService: schoolService,
+	}
+}
+
+func (h *SchoolHandler) Create(c *gin.Context) {
+	var input models.SchoolInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	school, err := h.schoolService.Create(c.Request.Context(), &input)
+	if err != nil {
+		respondSchoolError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, school)
+}
+
+func (h *SchoolHandler) List(c *gin.Context) {
+	offset, limit := getPagination(c)
+
+	schools, err := h.schoolService.List(c.Request.Context(), offset, limit)
+	if err != nil {
+		respondSchoolError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, schools)
+}
+
+func (h *SchoolHandler) Get(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid school ID format"})
+		return
+	}
+
+	school, err := h.schoolService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		respondSchoolError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, school)
+}
+
+func (h *SchoolHandler) GetMine(c *gin.Context) {
+	userID := c.GetInt64("userID")
+
+	school, err := h.schoolService.GetMine(c.Request.Context(), userID)
+	if err != nil {
+		respondSchoolError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, school)
+}
+
+func (h *SchoolHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid school ID format"})
+		return
+	}
+
+	var input models.SchoolInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	school, err := h.schoolService.Update(c.Request.Context(), id, &input, userID, c.GetString("userRole"))
+	if err != nil {
+		respondSchoolError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, school)
+}
+
+func (h *SchoolHandler) ListTerms(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid school ID format"})
+		return
+	}
+
+	terms, err := h.schoolService.ListTerms(c.Request.Context(), id)
+	if err != nil {
+		respondSchoolError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, terms)
+}
+
+func (h *SchoolHandler) CreateTerm(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid school ID format"})
+		return
+	}
+
+	var input models.SchoolTermInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	term, err := h.schoolService.CreateTerm(c.Request.Context(), id, &input, userID, c.GetString("userRole"))
+	if err != nil {
+		respondSchoolError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, term)
+}
+
+func (h *SchoolHandler) UpdateTerm(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid school ID format"})
+		return
+	}
+	termID, err := strconv.ParseInt(c.Param("term_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid term ID format"})
+		return
+	}
+
+	var input models.SchoolTermInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	term, err := h.schoolService.UpdateTerm(c.Request.Context(), id, termID, &input, userID, c.GetString("userRole"))
+	if err != nil {
+		respondSchoolError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, term)
+}
+
+func (h *SchoolHandler) DeleteTerm(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid school ID format"})
+		return
+	}
+	termID, err := strconv.ParseInt(c.Param("term_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid term ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	if err := h.schoolService.DeleteTerm(c.Request.Context(), id, termID, userID, c.GetString("userRole")); err != nil {
+		respondSchoolError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RequestLink asks to be accepted as a student of the school.
+func (h *SchoolHandler) RequestLink(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid school ID format"})
+		return
+	}
+
+	var input models.LinkSchoolInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	student, err := h.schoolService.RequestLink(c.Request.Context(), id, &input, userID)
+	if err != nil {
+		respondSchoolError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, student)
+}
+
+func (h *SchoolHandler) ListMine(c *gin.Context) {
+	userID := c.GetInt64("userID")
+
+	students, err := h.schoolService.ListMine(c.Request.Context(), userID)
+	if err != nil {
+		respondSchoolError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, students)
+}
+
+func (h *SchoolHandler) Unlink(c *gin.Context) {
+	linkID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid link ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	if err := h.schoolService.Unlink(c.Request.Context(), linkID, userID); err != nil {
+		respondSchoolError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *SchoolHandler) ListStudents(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid school ID format"})
+		return
+	}
+	offset, limit := getPagination(c)
+	status := models.SchoolStudentStatus(c.Query("status"))
+	userID := c.GetInt64("userID")
+
+	students, err := h.schoolService.ListStudents(c.Request.Context(), id, status, userID, c.GetString("userRole"), offset, limit)
+	if err != nil {
+		respondSchoolError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, students)
+}
+
+func (h *SchoolHandler) DecideStudent(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid school ID format"})
+		return
+	}
+	linkID, err := strconv.ParseInt(c.Param("link_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid link ID format"})
+		return
+	}
+
+	var input models.DecideSchoolStudentInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	student, err := h.schoolService.DecideStudent(c.Request.Context(), id, linkID, &input, userID, c.GetString("userRole"))
+	if err != nil {
+		respondSchoolError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, student)
+}
+
+// TermReport returns each student's approved hours against the term's
+// requirement, as JSON or, with ?format=csv, as a CSV download.
+func (h *SchoolHandler) TermReport(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid school ID format"})
+		return
+	}
+	termID, err := strconv.ParseInt(c.Param("term_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid term ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	report, err := h.schoolService.TermReport(c.Request.Context(), id, termID, userID, c.GetString("userRole"))
+	if err != nil {
+		respondSchoolError(c, err)
+		return
+	}
+
+	if c.Query("format") != "csv" {
+		c.JSON(http.StatusOK, report)
+		return
+	}
+
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=school-%d-term-%d-hours.csv", id, termID))
+	c.Status(http.StatusOK)
+
+	w := csv.NewWriter(c.Writer)
+	w.Write([]string{"volunteer_id", "name", "email", "student_number", "approved_hours", "required_hours", "remaining_hours", "complete"})
+	for _, student := range report.Students {
+		w.Write([]string{
+			strconv.FormatInt(student.VolunteerID, 10),
+			csvCell(student.Name),
+			csvCell(student.Email),
+			csvCell(student.StudentNumber),
+			strconv.FormatFloat(student.ApprovedHours, 'f', 2, 64),
+			strconv.FormatFloat(student.RequiredHours, 'f', 2, 64),
+			strconv.FormatFloat(student.RemainingHours, 'f', 2, 64),
+			strconv.FormatBool(student.Complete),
+		})
+	}
+	w.Flush()
+}
+
+func respondSchoolError(c *gin.Context, err error) {
+	switch err {
+	case service.ErrSchoolNotFound, service.ErrSchoolTermNotFound, service.ErrSchoolStudentNotFound,
+		service.ErrVolunteerNotFound, service.ErrUserNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case service.ErrNotSchoolAdmin, service.ErrInvalidSchoolTerm:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case service.ErrSchoolAdminTaken, service.ErrAlreadyLinkedToSchool:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case service.ErrUnauthorized:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
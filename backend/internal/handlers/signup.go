@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 	"volunteer-management/internal/models"
@@ -9,13 +10,15 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// SignupHandler serves the /api/signups endpoints, which present event
+// registrations as signups.
 type SignupHandler struct {
-	signupService *service.SignupService
+	registrationService *service.RegistrationService
 }
 
-func NewSignupHandler(signupService *service.SignupService) *SignupHandler {
+func NewSignupHandler(registrationService *service.RegistrationService) *SignupHandler {
 	return &SignupHandler{
-		signupService: signupService,
+		registrationService: registrationService,
 	}
 }
 
@@ -26,16 +29,58 @@ func (h *SignupHandler) Create(c *gin.Context) {
 		return
 	}
 
-	signup, err := h.signupService.Create(c.Request.Context(), &input)
+	input.SignerIP = c.ClientIP()
+	userID := c.GetInt64("userID")
+
+	signup, err := h.registrationService.Create(c.Request.Context(), &input, userID, c.GetString("userRole"))
 	if err != nil {
+		var validationErr *service.FormValidationError
+		if errors.As(err, &validationErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid answers", "fields": validationErr.Fields})
+			return
+		}
+		var conflictErr *service.ScheduleConflictError
+		if errors.As(err, &conflictErr) {
+			c.JSON(http.StatusConflict, gin.H{
+				"error":        conflictErr.Error(),
+				"conflicts":    conflictErr.Conflicts,
+				"can_override": conflictErr.Overridable,
+			})
+			return
+		}
+
+		var reliabilityErr *service.ReliabilityTooLowError
+		if errors.As(err, &reliabilityErr) {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":             reliabilityErr.Error(),
+				"reliability_score": reliabilityErr.Score,
+				"required_score":    reliabilityErr.Required,
+			})
+			return
+		}
+
+		var verificationErr *service.VerificationLevelTooLowError
+		if errors.As(err, &verificationErr) {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":                  verificationErr.Error(),
+				"verification_level":     verificationErr.Level,
+				"min_verification_level": verificationErr.Required,
+			})
+			return
+		}
+
 		switch err {
+		case service.ErrEventFormNotFound, service.ErrWaiverSignatureRequired, service.ErrInvalidEventHost:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		case service.ErrEventNotFound:
 			c.JSON(http.StatusNotFound, gin.H{"error": "Event not found"})
 		case service.ErrVolunteerNotFound:
 			c.JSON(http.StatusNotFound, gin.H{"error": "Volunteer not found"})
-		case service.ErrEventCapacityFull:
+		case service.ErrUnauthorized:
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case service.ErrEventCapacityFull, service.ErrEventNotOpen:
 			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
-		case service.ErrAlreadySignedUp:
+		case service.ErrAlreadyRegistered:
 			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
@@ -43,7 +88,7 @@ func (h *SignupHandler) Create(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, signup)
+	c.JSON(http.StatusCreated, models.NewSignup(signup))
 }
 
 func (h *SignupHandler) GetByID(c *gin.Context) {
@@ -53,10 +98,10 @@ func (h *SignupHandler) GetByID(c *gin.Context) {
 		return
 	}
 
-	signup, err := h.signupService.GetByID(c.Request.Context(), id)
+	signup, err := h.registrationService.GetByID(c.Request.Context(), id)
 	if err != nil {
 		switch err {
-		case service.ErrSignupNotFound:
+		case service.ErrRegistrationNotFound:
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
@@ -64,7 +109,7 @@ func (h *SignupHandler) GetByID(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, signup)
+	c.JSON(http.StatusOK, models.NewSignup(signup))
 }
 
 func (h *SignupHandler) Update(c *gin.Context) {
@@ -80,12 +125,16 @@ func (h *SignupHandler) Update(c *gin.Context) {
 		return
 	}
 
-	signup, err := h.signupService.Update(c.Request.Context(), id, &input)
+	userID := c.GetInt64("userID")
+
+	signup, err := h.registrationService.Update(c.Request.Context(), id, &input, userID, c.GetString("userRole"))
 	if err != nil {
 		switch err {
-		case service.ErrSignupNotFound:
+		case service.ErrRegistrationNotFound, service.ErrVolunteerNotFound:
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
-		case service.ErrInvalidSignupStatus:
+		case service.ErrUnauthorized:
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case service.ErrInvalidRegistrationState:
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
@@ -93,7 +142,7 @@ func (h *SignupHandler) Update(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, signup)
+	c.JSON(http.StatusOK, models.NewSignup(signup))
 }
 
 func (h *SignupHandler) Delete(c *gin.Context) {
@@ -103,8 +152,20 @@ func (h *SignupHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	if err := h.signupService.Delete(c.Request.Context(), id); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	userID := c.GetInt64("userID")
+
+	// Deleting a signup cancels it; the registration is kept for its history
+	if _, err := h.registrationService.Cancel(c.Request.Context(), id, userID, c.GetString("userRole")); err != nil {
+		switch err {
+		case service.ErrRegistrationNotFound, service.ErrVolunteerNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case service.ErrUnauthorized:
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case service.ErrInvalidRegistrationState:
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
 		return
 	}
 
@@ -121,13 +182,13 @@ func (h *SignupHandler) ListByEvent(c *gin.Context) {
 	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 
-	signups, err := h.signupService.ListByEvent(c.Request.Context(), eventID, offset, limit)
+	signups, err := h.registrationService.ListByEvent(c.Request.Context(), eventID, offset, limit)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
 		return
 	}
 
-	c.JSON(http.StatusOK, signups)
+	c.JSON(http.StatusOK, models.NewSignups(signups))
 }
 
 func (h *SignupHandler) ListByVolunteer(c *gin.Context) {
@@ -140,13 +201,69 @@ func (h *SignupHandler) ListByVolunteer(c *gin.Context) {
 	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 
-	signups, err := h.signupService.ListByVolunteer(c.Request.Context(), volunteerID, offset, limit)
+	signups, err := h.registrationService.ListByVolunteer(c.Request.Context(), volunteerID, offset, limit)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
 		return
 	}
 
-	c.JSON(http.StatusOK, signups)
+	c.JSON(http.StatusOK, models.NewSignups(signups))
+}
+
+func (h *SignupHandler) ListPending(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+	offset, limit := getPagination(c)
+
+	signups, err := h.registrationService.ListPending(c.Request.Context(), eventID, userID, offset, limit)
+	if err != nil {
+		respondSignupDecisionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.NewSignups(signups))
+}
+
+func (h *SignupHandler) Decide(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var input models.SignupDecisionInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	signup, err := h.registrationService.Decide(c.Request.Context(), id, &input, userID)
+	if err != nil {
+		respondSignupDecisionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.NewSignup(signup))
+}
+
+func respondSignupDecisionError(c *gin.Context, err error) {
+	switch err {
+	case service.ErrRegistrationNotFound, service.ErrEventNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case service.ErrUnauthorized:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case service.ErrRegistrationNotPending, service.ErrEventCapacityFull:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
 }
 
 func (h *SignupHandler) RegisterRoutes(router *gin.Engine, authMiddleware gin.HandlerFunc) {
@@ -161,3 +278,35 @@ func (h *SignupHandler) RegisterRoutes(router *gin.Engine, authMiddleware gin.Ha
 		signups.GET("/volunteer/:volunteerId", h.ListByVolunteer)
 	}
 }
+
+func (h *SignupHandler) GetMySchedule(c *gin.Context) {
+	from, err := parseDateQuery(c.Query("from"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format. Use YYYY-MM-DD"})
+		return
+	}
+	to, err := parseDateQuery(c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format. Use YYYY-MM-DD"})
+		return
+	}
+	// Include the whole "to" day
+	if !to.IsZero() {
+		to = to.AddDate(0, 0, 1)
+	}
+
+	userID := c.GetInt64("userID")
+
+	schedule, err := h.registrationService.GetSchedule(c.Request.Context(), userID, from, to)
+	if err != nil {
+		switch err {
+		case service.ErrVolunteerNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Volunteer not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, schedule)
+}
@@ -2,6 +2,8 @@ package handlers
 
 import (
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -62,3 +64,22 @@ func getBoolParam(c *gin.Context, param string, defaultValue bool) bool {
 	}
 	return value
 }
+
+// parseDateQuery parses an optional YYYY-MM-DD value, returning the zero time
+// when it is empty
+func parseDateQuery(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
+
+// csvCell keeps user-entered text from being run as a formula when a CSV
+// export is opened in a spreadsheet, by prefixing cells that start with a
+// formula character with a quote
+func csvCell(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type VerificationHandler struct {
+	verificationService *service.VerificationService
+	maxUploadSize       int64
+}
+
+func NewVerificationHandler(verificationService *service.VerificationService, maxUploadSize int64) *VerificationHandler {
+	return &VerificationHandler{
+		verificationService: verificationService,
+		maxUploadSize:       maxUploadSize,
+	}
+}
+
+// Upload accepts a PDF, JPEG or PNG document in the multipart field "file",
+// with its "type" and, for certifications, an optional "expires_at" date
+// (YYYY-MM-DD).
+func (h *VerificationHandler) Upload(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxUploadSize+multipartOverhead)
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		if strings.Contains(err.Error(), "request body too large") {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": service.ErrDocumentTooLarge.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "A file is required in the \"file\" field"})
+		return
+	}
+
+	var input models.UploadVerificationDocumentInput
+	if err := c.ShouldBind(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Could not read uploaded file"})
+		return
+	}
+	defer file.Close()
+
+	userID := c.GetInt64("userID")
+
+	document, err := h.verificationService.Upload(c.Request.Context(), file, header.Filename, &input, userID)
+	if err != nil {
+		respondVerificationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, document)
+}
+
+func (h *VerificationHandler) GetMine(c *gin.Context) {
+	userID := c.GetInt64("userID")
+
+	status, err := h.verificationService.GetMine(c.Request.Context(), userID)
+	if err != nil {
+		respondVerificationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, status)
+}
+
+func (h *VerificationHandler) GetForVolunteer(c *gin.Context) {
+	volunteerID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid volunteer ID format"})
+		return
+	}
+	userID := c.GetInt64("userID")
+
+	status, err := h.verificationService.GetForVolunteer(c.Request.Context(), volunteerID, userID, c.GetString("userRole"))
+	if err != nil {
+		respondVerificationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, status)
+}
+
+func (h *VerificationHandler) ListPending(c *gin.Context) {
+	offset, limit := getPagination(c)
+	userID := c.GetInt64("userID")
+
+	documents, err := h.verificationService.ListPending(c.Request.Context(), userID, c.GetString("userRole"), offset, limit)
+	if err != nil {
+		respondVerificationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, documents)
+}
+
+// Download streams a document to its owner or a reviewer. Documents are
+// never cached by the browser or intermediaries.
+func (h *VerificationHandler) Download(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid document ID format"})
+		return
+	}
+	userID := c.GetInt64("userID")
+
+	document, file, err := h.verificationService.Open(c.Request.Context(), id, userID, c.GetString("userRole"))
+	if err != nil {
+		respondVerificationError(c, err)
+		return
+	}
+	defer file.Close()
+
+	c.Header("Cache-Control", "no-store")
+	c.DataFromReader(http.StatusOK, document.Size, document.ContentType, file, map[string]string{
+		"Content-Disposition": fmt.Sprintf("attachment; filename=%q", document.FileName),
+	})
+}
+
+func (h *VerificationHandler) Review(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid document ID format"})
+		return
+	}
+
+	var input models.ReviewVerificationDocumentInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	document, err := h.verificationService.Review(c.Request.Context(), id, &input, userID, c.GetString("userRole"))
+	if err != nil {
+		respondVerificationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, document)
+}
+
+func (h *VerificationHandler) Withdraw(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid document ID format"})
+		return
+	}
+	userID := c.GetInt64("userID")
+
+	if err := h.verificationService.Withdraw(c.Request.Context(), id, userID); err != nil {
+		respondVerificationError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func respondVerificationError(c *gin.Context, err error) {
+	var validationErr *service.FormValidationError
+	if errors.As(err, &validationErr) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid review", "fields": validationErr.Fields})
+		return
+	}
+
+	switch err {
+	case service.ErrVolunteerNotFound, service.ErrVerificationDocumentNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case service.ErrDocumentTooLarge:
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+	case service.ErrUnsupportedDocumentType:
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+	case service.ErrDocumentAlreadyReviewed:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case service.ErrUnauthorized:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WaiverHandler struct {
+	waiverService *service.WaiverService
+}
+
+func NewWaiverHandler(waiverService *service.WaiverService) *WaiverHandler {
+	return &WaiverHandler{
+		waiverService: waiverService,
+	}
+}
+
+func (h *WaiverHandler) Create(c *gin.Context) {
+	var input models.CreateWaiverInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	waiver, err := h.waiverService.Create(c.Request.Context(), &input, userID)
+	if err != nil {
+		respondWaiverError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, waiver)
+}
+
+func (h *WaiverHandler) List(c *gin.Context) {
+	userID := c.GetInt64("userID")
+
+	waivers, err := h.waiverService.ListByOrganization(c.Request.Context(), userID)
+	if err != nil {
+		respondWaiverError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, waivers)
+}
+
+func (h *WaiverHandler) GetByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid waiver ID format"})
+		return
+	}
+
+	waiver, err := h.waiverService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		respondWaiverError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, waiver)
+}
+
+func (h *WaiverHandler) AddVersion(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid waiver ID format"})
+		return
+	}
+
+	var input models.CreateWaiverVersionInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	version, err := h.waiverService.AddVersion(c.Request.Context(), id, &input, userID)
+	if err != nil {
+		respondWaiverError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, version)
+}
+
+func (h *WaiverHandler) AttachToEvent(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+
+	var input models.AttachWaiverInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	event, err := h.waiverService.AttachToEvent(c.Request.Context(), eventID, &input, userID)
+	if err != nil {
+		respondWaiverError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, event)
+}
+
+func (h *WaiverHandler) GetForEvent(c *gin.Context) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+
+	waiver, err := h.waiverService.GetForEvent(c.Request.Context(), eventID, userID)
+	if err != nil {
+		respondWaiverError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, waiver)
+}
+
+// DownloadSignature serves a signed copy of the waiver as a PDF.
+func (h *WaiverHandler) DownloadSignature(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid signature ID format"})
+		return
+	}
+
+	userID := c.GetInt64("userID")
+	role := c.GetString("userRole")
+
+	document, err := h.waiverService.SignaturePDF(c.Request.Context(), id, userID, role)
+	if err != nil {
+		respondWaiverError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=waiver-signature-%d.pdf", id))
+	c.Data(http.StatusOK, "application/pdf", document)
+}
+
+func respondWaiverError(c *gin.Context, err error) {
+	switch err {
+	case service.ErrWaiverNotFound, service.ErrWaiverSignatureNotFound, service.ErrEventNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case service.ErrUnauthorized:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
@@ -0,0 +1,53 @@
+package models
+
+// AttendanceEntry marks one volunteer attended or no-show. Volunteers are
+// identified by ID or by the email they signed in with. Attended volunteers
+// are credited the event's length unless Hours overrides it.
+type AttendanceEntry struct {
+	VolunteerID int64    `json:"volunteer_id,omitempty"`
+	Email       string   `json:"email,omitempty"`
+	Status      string   `json:"status" binding:"required,oneof=attended no-show"`
+	Hours       *float64 `json:"hours,omitempty" binding:"omitempty,min=0,max=24"`
+}
+
+type BulkAttendanceInput struct {
+	Entries []AttendanceEntry `json:"entries" binding:"required,min=1,max=1000,dive"`
+}
+
+// Attendee is a volunteer whose attendance can be recorded for an event:
+// anyone whose registration holds a seat.
+type Attendee struct {
+	VolunteerID        int64  `json:"volunteer_id"`
+	HostOrganizationID *int64 `json:"host_organization_id,omitempty"`
+	Name               string `json:"name"`
+	Email              string `json:"email"`
+}
+
+// AttendanceUpdate is a resolved entry ready to be applied.
+type AttendanceUpdate struct {
+	VolunteerID        int64
+	HostOrganizationID *int64
+	Status             string
+	Hours              float64
+}
+
+// AttendanceRowResult reports what happened to one entry of a bulk update.
+type AttendanceRowResult struct {
+	Row         int      `json:"row"` // position in the request, or line number in a CSV upload
+	VolunteerID int64    `json:"volunteer_id,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Email       string   `json:"email,omitempty"`
+	Status      string   `json:"status,omitempty"`
+	Hours       *float64 `json:"hours,omitempty"`
+	Error       string   `json:"error,omitempty"`
+}
+
+// BulkAttendanceReport is the outcome of a bulk update. Updates are all or
+// nothing: when any row fails, no row is applied.
+type BulkAttendanceReport struct {
+	EventID int64                  `json:"event_id"`
+	Applied bool                   `json:"applied"`
+	Updated int                    `json:"updated"`
+	Failed  int                    `json:"failed"`
+	Rows    []*AttendanceRowResult `json:"rows"`
+}
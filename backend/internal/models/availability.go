@@ -0,0 +1,112 @@
+package models
+
+import "time"
+
+// DefaultVolunteerTimeZone is used for volunteers who have not set one.
+const DefaultVolunteerTimeZone = "UTC"
+
+// AvailabilityWindow is a period a volunteer is free every week, on the wall
+// clock of their time zone. Times are "HH:MM"; a window ends on the day it
+// starts, with "24:00" meaning midnight.
+type AvailabilityWindow struct {
+	ID          int64     `json:"id"`
+	VolunteerID int64     `json:"volunteer_id"`
+	Weekday     int       `json:"weekday"` // 0 is Sunday
+	StartTime   string    `json:"start_time"`
+	EndTime     string    `json:"end_time"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// AvailabilityBlackout is a range of days, inclusive, when the volunteer is
+// away regardless of their weekly windows.
+type AvailabilityBlackout struct {
+	ID          int64     `json:"id"`
+	VolunteerID int64     `json:"volunteer_id"`
+	StartDate   time.Time `json:"start_date" gorm:"type:date"`
+	EndDate     time.Time `json:"end_date" gorm:"type:date"`
+	Reason      string    `json:"reason,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// VolunteerAvailability is a volunteer's weekly windows and blackouts.
+type VolunteerAvailability struct {
+	VolunteerID int64                   `json:"volunteer_id"`
+	TimeZone    string                  `json:"time_zone"`
+	Note        string                  `json:"note,omitempty"` // the free-text availability on the profile
+	Windows     []*AvailabilityWindow   `json:"windows"`
+	Blackouts   []*AvailabilityBlackout `json:"blackouts"`
+}
+
+type AvailabilityWindowInput struct {
+	Weekday   *int   `json:"weekday" binding:"required,min=0,max=6"`
+	StartTime string `json:"start_time" binding:"required"`
+	EndTime   string `json:"end_time" binding:"required"`
+}
+
+type AvailabilityBlackoutInput struct {
+	StartDate string `json:"start_date" binding:"required"` // YYYY-MM-DD
+	EndDate   string `json:"end_date" binding:"required"`
+	Reason    string `json:"reason,omitempty" binding:"max=500"`
+}
+
+type SetAvailabilityTimeZoneInput struct {
+	TimeZone string `json:"time_zone" binding:"required"`
+}
+
+// Zone returns the volunteer's time zone, falling back to UTC when it is
+// unset or unknown.
+func (a *VolunteerAvailability) Zone() *time.Location {
+	if a.TimeZone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(a.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// Covers reports whether the weekly windows cover the whole of a shift. A
+// shift running past midnight needs a window to midnight on the first day
+// and one from midnight on the next.
+func (a *VolunteerAvailability) Covers(start, end time.Time) bool {
+	loc := a.Zone()
+	start, end = start.In(loc), end.In(loc)
+	lastDay := end.Add(-time.Second)
+
+	endClock := end.Format("15:04")
+	if end.YearDay() != start.YearDay() || end.Year() != start.Year() {
+		endClock = "24:00"
+	}
+	if !a.hasWindow(start.Weekday(), start.Format("15:04"), endClock) {
+		return false
+	}
+	if lastDay.YearDay() == start.YearDay() && lastDay.Year() == start.Year() {
+		return true
+	}
+	return a.hasWindow(end.Weekday(), "00:00", end.Format("15:04"))
+}
+
+func (a *VolunteerAvailability) hasWindow(weekday time.Weekday, from, to string) bool {
+	for _, window := range a.Windows {
+		if window.Weekday == int(weekday) && window.StartTime <= from && window.EndTime >= to {
+			return true
+		}
+	}
+	return false
+}
+
+// BlackedOut reports whether any day the shift touches falls in a blackout.
+func (a *VolunteerAvailability) BlackedOut(start, end time.Time) bool {
+	loc := a.Zone()
+	first := start.In(loc).Format("2006-01-02")
+	last := end.In(loc).Add(-time.Second).Format("2006-01-02")
+	for _, blackout := range a.Blackouts {
+		if blackout.StartDate.Format("2006-01-02") <= last && blackout.EndDate.Format("2006-01-02") >= first {
+			return true
+		}
+	}
+	return false
+}
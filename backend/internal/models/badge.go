@@ -0,0 +1,72 @@
+package models
+
+import "time"
+
+type BadgeRuleType string
+
+const (
+	// BadgeRuleTotalHours awards once the volunteer's approved hours reach
+	// the threshold.
+	BadgeRuleTotalHours BadgeRuleType = "total_hours"
+	// BadgeRuleCategoryEvents awards after attending the threshold number of
+	// events in the badge's category.
+	BadgeRuleCategoryEvents BadgeRuleType = "category_events"
+	// BadgeRuleConsecutiveMonths awards after attending at least one event in
+	// each of the threshold number of calendar months in a row.
+	BadgeRuleConsecutiveMonths BadgeRuleType = "consecutive_months"
+	// BadgeRuleNewOrganization awards once per organization, for the first
+	// event attended with it, or only for the badge's organization when set.
+	BadgeRuleNewOrganization BadgeRuleType = "new_organization"
+)
+
+// Badge is an admin-defined rule that awards an achievement.
+type Badge struct {
+	ID             int64         `json:"id"`
+	Name           string        `json:"name"`
+	Description    string        `json:"description"`
+	Icon           string        `json:"icon,omitempty"`
+	RuleType       BadgeRuleType `json:"rule_type"`
+	Threshold      float64       `json:"threshold,omitempty"`
+	Category       string        `json:"category,omitempty"`        // for category_events
+	OrganizationID *int64        `json:"organization_id,omitempty"` // for new_organization
+	Active         bool          `json:"active"`
+	CreatedBy      int64         `json:"created_by"`
+	CreatedAt      time.Time     `json:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at"`
+}
+
+type BadgeInput struct {
+	Name           string        `json:"name" binding:"required,max=100"`
+	Description    string        `json:"description" binding:"required,max=500"`
+	Icon           string        `json:"icon,omitempty" binding:"max=100"`
+	RuleType       BadgeRuleType `json:"rule_type" binding:"required,oneof=total_hours category_events consecutive_months new_organization"`
+	Threshold      float64       `json:"threshold,omitempty" binding:"min=0"`
+	Category       string        `json:"category,omitempty"`
+	OrganizationID *int64        `json:"organization_id,omitempty"`
+	Active         *bool         `json:"active,omitempty"` // defaults to true
+}
+
+// BadgeStats is what badge rules are evaluated against: the volunteer's
+// approved hours and the events they attended.
+type BadgeStats struct {
+	TotalHours       float64
+	EventsByCategory map[string]int // keyed by lower-case category
+	ActiveMonths     []time.Time    // first day of each month with an attended event, ascending
+	Organizations    []*BadgeOrganization
+}
+
+// BadgeOrganization is an organization the volunteer attended an event with.
+type BadgeOrganization struct {
+	ID            int64
+	Name          string
+	FirstAttended time.Time
+}
+
+// BadgeProgress shows a volunteer how close they are to a badge.
+type BadgeProgress struct {
+	Badge    *Badge         `json:"badge"`
+	Current  float64        `json:"current"`
+	Target   float64        `json:"target"`
+	Earned   []*Achievement `json:"earned,omitempty"`
+	Complete bool           `json:"complete"`
+}
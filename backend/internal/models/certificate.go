@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+type IssuedDocumentKind string
+
+const (
+	// IssuedDocumentTranscript lists all of a volunteer's approved hours.
+	IssuedDocumentTranscript IssuedDocumentKind = "transcript"
+	// IssuedDocumentCertificate is an organization's certificate for the
+	// hours a volunteer served at one event.
+	IssuedDocumentCertificate IssuedDocumentKind = "certificate"
+)
+
+// ServiceHoursEntry is one event on a transcript or certificate, with the
+// approved hours and the coordinator who approved them.
+type ServiceHoursEntry struct {
+	EventID          int64      `json:"event_id"`
+	EventTitle       string     `json:"event_title"`
+	OrganizationName string     `json:"organization_name"`
+	Date             time.Time  `json:"date"`
+	Hours            float64    `json:"hours"`
+	ApprovedBy       string     `json:"approved_by,omitempty"`
+	ApprovedAt       *time.Time `json:"approved_at,omitempty"`
+}
+
+// IssuedDocument records a transcript or certificate as it was issued, so
+// that its verification code can be checked later. Signature covers the
+// recorded contents; ContentHash is the SHA-256 of the PDF handed out.
+type IssuedDocument struct {
+	ID             int64               `json:"id"`
+	Code           string              `json:"code"`
+	Kind           IssuedDocumentKind  `json:"kind"`
+	VolunteerID    int64               `json:"volunteer_id"`
+	VolunteerName  string              `json:"volunteer_name"`
+	EventID        *int64              `json:"event_id,omitempty"`        // certificates only
+	OrganizationID *int64              `json:"organization_id,omitempty"` // certificates only
+	TotalHours     float64             `json:"total_hours"`
+	Entries        []ServiceHoursEntry `json:"entries" gorm:"serializer:json"`
+	Signature      string              `json:"signature"`
+	ContentHash    string              `json:"content_hash"`
+	IssuedBy       int64               `json:"issued_by"`
+	IssuedAt       time.Time           `json:"issued_at"`
+}
+
+// DocumentVerification is the public answer to a verification code. Valid is
+// false when the record no longer matches its signature.
+type DocumentVerification struct {
+	Valid         bool                `json:"valid"`
+	Code          string              `json:"code"`
+	Kind          IssuedDocumentKind  `json:"kind"`
+	VolunteerName string              `json:"volunteer_name"`
+	TotalHours    float64             `json:"total_hours"`
+	Entries       []ServiceHoursEntry `json:"entries"`
+	ContentHash   string              `json:"content_hash"` // SHA-256 of the genuine PDF
+	IssuedAt      time.Time           `json:"issued_at"`
+}
@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+// EmergencyInfo is a volunteer's emergency contact and medical notes, such
+// as allergies, in plain text. It is only ever stored encrypted.
+type EmergencyInfo struct {
+	ContactName         string `json:"contact_name,omitempty" binding:"max=255"`
+	ContactPhone        string `json:"contact_phone,omitempty" binding:"max=50"`
+	ContactRelationship string `json:"contact_relationship,omitempty" binding:"max=100"`
+	MedicalNotes        string `json:"medical_notes,omitempty" binding:"max=2000"`
+}
+
+// EmergencyInfoRecord is EmergencyInfo as stored: sealed under its own data
+// key, which is wrapped with the configured master key KeyID.
+type EmergencyInfoRecord struct {
+	VolunteerID int64 `gorm:"primaryKey"`
+	KeyID       string
+	WrappedKey  []byte
+	Ciphertext  []byte
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// VolunteerEmergencyInfo is what a coordinator sees for one volunteer
+// registered to their event.
+type VolunteerEmergencyInfo struct {
+	VolunteerID int64          `json:"volunteer_id"`
+	Name        string         `json:"name"`
+	Info        *EmergencyInfo `json:"info,omitempty"` // nil when none was given
+	UpdatedAt   *time.Time     `json:"updated_at,omitempty"`
+}
+
+// EmergencyInfoAccess records a coordinator reading a volunteer's emergency
+// info.
+type EmergencyInfoAccess struct {
+	ID               int64     `json:"id"`
+	VolunteerID      int64     `json:"volunteer_id"`
+	EventID          int64     `json:"event_id"`
+	EventTitle       string    `json:"event_title,omitempty" gorm:"->"`
+	OrganizationID   int64     `json:"organization_id"`
+	OrganizationName string    `json:"organization_name,omitempty" gorm:"->"`
+	AccessedBy       int64     `json:"accessed_by"`
+	AccessedByName   string    `json:"accessed_by_name,omitempty" gorm:"->"`
+	IPAddress        string    `json:"ip_address,omitempty"`
+	AccessedAt       time.Time `json:"accessed_at"`
+}
@@ -2,8 +2,13 @@ package models
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
+// DefaultEventTimeZone is used for events created without a time zone.
+const DefaultEventTimeZone = "UTC"
+
 type EventStatus string
 
 const (
@@ -11,39 +16,131 @@ const (
 	EventStatusActive    EventStatus = "active"
 	EventStatusCancelled EventStatus = "cancelled"
 	EventStatusComplete  EventStatus = "complete"
+
+	// Review states for events published by organizations that are not trusted
+	EventStatusPendingReview    EventStatus = "pending_review"
+	EventStatusChangesRequested EventStatus = "changes_requested"
+	EventStatusRejected         EventStatus = "rejected"
 )
 
-type ImpactMetrics struct {
-	TreesPlanted      int     `json:"trees_planted,omitempty"`
-	WasteCollected    float64 `json:"waste_collected,omitempty"`
-	MealsServed       int     `json:"meals_served,omitempty"`
-	PeopleHelped      int     `json:"people_helped,omitempty"`
-	AreaCleaned       float64 `json:"area_cleaned,omitempty"`
-	FundsRaised       float64 `json:"funds_raised,omitempty"`
-	EducationHours    int     `json:"education_hours,omitempty"`
-	CommunityProjects int     `json:"community_projects,omitempty"`
+// EventReviewStatuses are the statuses of events that have not passed
+// review. Only the organization and admins can see such events.
+var EventReviewStatuses = []EventStatus{
+	EventStatusPendingReview, EventStatusChangesRequested, EventStatusRejected,
 }
 
+// EventSignupApproval decides whether signups are confirmed right away or
+// wait for a coordinator.
+type EventSignupApproval string
+
+const (
+	SignupApprovalAuto   EventSignupApproval = "auto"
+	SignupApprovalManual EventSignupApproval = "manual"
+)
+
+type EventReviewDecision string
+
+const (
+	ReviewDecisionApprove        EventReviewDecision = "approve"
+	ReviewDecisionReject         EventReviewDecision = "reject"
+	ReviewDecisionRequestChanges EventReviewDecision = "request_changes"
+)
+
 type Event struct {
-	ID                   int64         `json:"id"`
-	Title                string        `json:"title"`
-	Description          string        `json:"description"`
-	ShortDescription     string        `json:"short_description,omitempty"`
-	Location             string        `json:"location"`
-	Date                 time.Time     `json:"date"`
-	StartTime            time.Time     `json:"start_time"`
-	EndTime              time.Time     `json:"end_time"`
-	OrganizationID       int64         `json:"organization_id"`
-	OrganizationName     string        `json:"organization_name,omitempty"`
-	Category             string        `json:"category,omitempty"`
-	Image                string        `json:"image,omitempty"`
-	RequiredSkills       []string      `json:"required_skills,omitempty"`
-	VolunteersNeeded     int           `json:"volunteers_needed"`
-	VolunteersRegistered int           `json:"volunteers_registered"`
-	Status               EventStatus   `json:"status"`
-	ImpactMetrics        ImpactMetrics `json:"impact_metrics,omitempty"`
-	CreatedAt            time.Time     `json:"created_at"`
-	UpdatedAt            time.Time     `json:"updated_at"`
+	ID                   int64               `json:"id"`
+	Title                string              `json:"title"`
+	Description          string              `json:"description"`
+	ShortDescription     string              `json:"short_description,omitempty"`
+	Location             string              `json:"location"`
+	Date                 time.Time           `json:"date"`
+	StartTime            time.Time           `json:"start_time"`
+	EndTime              time.Time           `json:"end_time"`
+	TimeZone             string              `json:"time_zone"` // IANA name, e.g. "America/Chicago"
+	Local                *EventLocalTimes    `json:"local,omitempty" gorm:"-"`
+	OrganizationID       int64               `json:"organization_id"`
+	OrganizationName     string              `json:"organization_name,omitempty"`
+	Category             string              `json:"category,omitempty"`
+	Image                string              `json:"image,omitempty"`
+	RequiredSkills       []string            `json:"required_skills,omitempty"`
+	VolunteersNeeded     int                 `json:"volunteers_needed"`
+	VolunteersRegistered int                 `json:"volunteers_registered"`
+	Status               EventStatus         `json:"status"`
+	SignupApproval       EventSignupApproval `json:"signup_approval"`
+	MinVerificationLevel VerificationLevel   `json:"min_verification_level"` // volunteers below it cannot sign up
+	RatingAverage        float64             `json:"rating_average"`         // volunteers' average event rating
+	RatingCount          int                 `json:"rating_count"`
+	WaiverID             *int64              `json:"waiver_id,omitempty"`
+	CancellationMessage  string              `json:"cancellation_message,omitempty"`
+	CancelledAt          *time.Time          `json:"cancelled_at,omitempty"`
+	ReviewNote           string              `json:"review_note,omitempty"`
+	ReviewedBy           *int64              `json:"reviewed_by,omitempty"`
+	ReviewedAt           *time.Time          `json:"reviewed_at,omitempty"`
+	CreatedAt            time.Time           `json:"created_at"`
+	UpdatedAt            time.Time           `json:"updated_at"`
+}
+
+// EventLocalTimes repeats the event's times in its own time zone. Date is the
+// local calendar day the event starts on.
+type EventLocalTimes struct {
+	Date      string    `json:"date"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+// InReview reports whether the event has not passed review yet.
+func (e *Event) InReview() bool {
+	for _, status := range EventReviewStatuses {
+		if e.Status == status {
+			return true
+		}
+	}
+	return false
+}
+
+// Zone returns the event's time zone, falling back to UTC when it is unset or
+// unknown.
+func (e *Event) Zone() *time.Location {
+	if e.TimeZone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(e.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// Localize normalizes the stored times to UTC and fills in Local.
+func (e *Event) Localize() {
+	loc := e.Zone()
+	e.Date = e.Date.UTC()
+	e.StartTime = e.StartTime.UTC()
+	e.EndTime = e.EndTime.UTC()
+
+	start := e.StartTime
+	if start.IsZero() {
+		start = e.Date
+	}
+	e.Local = &EventLocalTimes{
+		Date:      start.In(loc).Format("2006-01-02"),
+		StartTime: e.StartTime.In(loc),
+		EndTime:   e.EndTime.In(loc),
+	}
+}
+
+func (e *Event) AfterFind(tx *gorm.DB) error {
+	e.Localize()
+	return nil
+}
+
+func (e *Event) AfterCreate(tx *gorm.DB) error {
+	e.Localize()
+	return nil
+}
+
+func (e *Event) AfterUpdate(tx *gorm.DB) error {
+	e.Localize()
+	return nil
 }
 
 type CreateEventInput struct {
@@ -54,51 +151,45 @@ type CreateEventInput struct {
 	Date             time.Time   `json:"date" binding:"required"`
 	StartTime        time.Time   `json:"start_time" binding:"required"`
 	EndTime          time.Time   `json:"end_time" binding:"required"`
+	TimeZone         string      `json:"time_zone,omitempty"`
 	Category         string      `json:"category,omitempty"`
 	Image            string      `json:"image,omitempty"`
 	RequiredSkills   []string    `json:"required_skills,omitempty"`
 	VolunteersNeeded int         `json:"volunteers_needed" binding:"required,min=1"`
 	Status           EventStatus `json:"status" binding:"required,oneof=draft active cancelled complete"`
+	// SignupApproval defaults to auto
+	SignupApproval EventSignupApproval `json:"signup_approval,omitempty" binding:"omitempty,oneof=auto manual"`
+	// MinVerificationLevel defaults to none
+	MinVerificationLevel VerificationLevel `json:"min_verification_level,omitempty" binding:"omitempty,oneof=none basic verified trusted"`
 }
 
 type UpdateEventInput struct {
-	Title            *string        `json:"title,omitempty"`
-	Description      *string        `json:"description,omitempty"`
-	ShortDescription *string        `json:"short_description,omitempty"`
-	Location         *string        `json:"location,omitempty"`
-	Date             *time.Time     `json:"date,omitempty"`
-	StartTime        *time.Time     `json:"start_time,omitempty"`
-	EndTime          *time.Time     `json:"end_time,omitempty"`
-	Category         *string        `json:"category,omitempty"`
-	Image            *string        `json:"image,omitempty"`
-	RequiredSkills   *[]string      `json:"required_skills,omitempty"`
-	VolunteersNeeded *int           `json:"volunteers_needed,omitempty" binding:"omitempty,min=1"`
-	Status           *EventStatus   `json:"status,omitempty" binding:"omitempty,oneof=draft active cancelled complete"`
-	ImpactMetrics    *ImpactMetrics `json:"impact_metrics,omitempty"`
-}
-
-type EventRegistration struct {
-	ID               int64     `json:"id"`
-	EventID          int64     `json:"event_id"`
-	VolunteerID      int64     `json:"volunteer_id"`
-	Status           string    `json:"status"` // registered, attended, cancelled, no-show
-	HoursLogged      float64   `json:"hours_logged,omitempty"`
-	Feedback         string    `json:"feedback,omitempty"`
-	Rating           int       `json:"rating,omitempty"` // 1-5 stars
-	RegistrationDate time.Time `json:"registration_date"`
-	CreatedAt        time.Time `json:"created_at"`
-	UpdatedAt        time.Time `json:"updated_at"`
+	Title                *string              `json:"title,omitempty"`
+	Description          *string              `json:"description,omitempty"`
+	ShortDescription     *string              `json:"short_description,omitempty"`
+	Location             *string              `json:"location,omitempty"`
+	Date                 *time.Time           `json:"date,omitempty"`
+	StartTime            *time.Time           `json:"start_time,omitempty"`
+	EndTime              *time.Time           `json:"end_time,omitempty"`
+	TimeZone             *string              `json:"time_zone,omitempty"`
+	Category             *string              `json:"category,omitempty"`
+	Image                *string              `json:"image,omitempty"`
+	RequiredSkills       *[]string            `json:"required_skills,omitempty"`
+	VolunteersNeeded     *int                 `json:"volunteers_needed,omitempty" binding:"omitempty,min=1"`
+	Status               *EventStatus         `json:"status,omitempty" binding:"omitempty,oneof=draft active cancelled complete"`
+	SignupApproval       *EventSignupApproval `json:"signup_approval,omitempty" binding:"omitempty,oneof=auto manual"`
+	MinVerificationLevel *VerificationLevel   `json:"min_verification_level,omitempty" binding:"omitempty,oneof=none basic verified trusted"`
 }
 
-type CreateEventRegistrationInput struct {
-	EventID     int64  `json:"event_id" binding:"required"`
-	VolunteerID int64  `json:"volunteer_id" binding:"required"`
-	Status      string `json:"status" binding:"required,oneof=registered attended cancelled no-show"`
+// CancelEventInput carries the optional note the organizer sends to registrants
+// when an event is cancelled.
+type CancelEventInput struct {
+	Message string `json:"message,omitempty"`
 }
 
-type UpdateEventRegistrationInput struct {
-	Status      *string  `json:"status,omitempty" binding:"omitempty,oneof=registered attended cancelled no-show"`
-	HoursLogged *float64 `json:"hours_logged,omitempty"`
-	Feedback    *string  `json:"feedback,omitempty"`
-	Rating      *int     `json:"rating,omitempty" binding:"omitempty,min=1,max=5"`
+// ReviewEventInput is an admin's moderation decision. A reason is required
+// unless the event is approved.
+type ReviewEventInput struct {
+	Decision EventReviewDecision `json:"decision" binding:"required,oneof=approve reject request_changes"`
+	Reason   string              `json:"reason,omitempty"`
 }
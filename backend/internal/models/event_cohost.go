@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+)
+
+type CohostStatus string
+
+const (
+	CohostStatusPending  CohostStatus = "pending"
+	CohostStatusAccepted CohostStatus = "accepted"
+	CohostStatusDeclined CohostStatus = "declined"
+)
+
+// EventCohost links an event to an organization that runs it together with
+// the event's primary organization. Accepted co-hosts can edit the event.
+type EventCohost struct {
+	ID               int64        `json:"id"`
+	EventID          int64        `json:"event_id"`
+	OrganizationID   int64        `json:"organization_id"`
+	OrganizationName string       `json:"organization_name,omitempty" gorm:"->"`
+	Status           CohostStatus `json:"status"`
+	InvitedBy        int64        `json:"invited_by"`
+	RespondedAt      *time.Time   `json:"responded_at,omitempty"`
+	CreatedAt        time.Time    `json:"created_at"`
+	UpdatedAt        time.Time    `json:"updated_at"`
+}
+
+type InviteCohostInput struct {
+	OrganizationID int64 `json:"organization_id" binding:"required"`
+}
+
+type RespondCohostInput struct {
+	Accept *bool `json:"accept" binding:"required"`
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+)
+
+type FormQuestionType string
+
+const (
+	QuestionTypeText         FormQuestionType = "text"
+	QuestionTypeSingleChoice FormQuestionType = "single_choice"
+	QuestionTypeMultiChoice  FormQuestionType = "multi_choice"
+	QuestionTypeNumber       FormQuestionType = "number"
+	QuestionTypeBoolean      FormQuestionType = "boolean"
+)
+
+type FormQuestion struct {
+	ID       string           `json:"id" binding:"required"`
+	Label    string           `json:"label" binding:"required"`
+	Type     FormQuestionType `json:"type" binding:"required,oneof=text single_choice multi_choice number boolean"`
+	Required bool             `json:"required"`
+	Options  []string         `json:"options,omitempty"` // choices for single_choice and multi_choice
+}
+
+// EventForm is one version of an event's registration questions. Saving a form
+// always creates a new version so existing answers keep their original wording.
+type EventForm struct {
+	ID        int64          `json:"id"`
+	EventID   int64          `json:"event_id"`
+	Version   int            `json:"version"`
+	Questions []FormQuestion `json:"questions" gorm:"serializer:json"`
+	CreatedBy int64          `json:"created_by"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+type SaveEventFormInput struct {
+	Questions []FormQuestion `json:"questions" binding:"required,dive"`
+}
+
+// RegistrationAnswerExport is one answer to one question, labelled with the
+// wording of the form version the volunteer actually answered.
+type RegistrationAnswerExport struct {
+	RegistrationID int64  `json:"registration_id"`
+	VolunteerID    int64  `json:"volunteer_id"`
+	Status         string `json:"status"`
+	FormVersion    int    `json:"form_version"`
+	QuestionID     string `json:"question_id"`
+	QuestionLabel  string `json:"question_label"`
+	Answer         string `json:"answer"`
+}
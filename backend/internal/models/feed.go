@@ -0,0 +1,84 @@
+package models
+
+import "time"
+
+// OrganizationFollow is a volunteer following an organization. Followers who
+// set ShareProfile are visible to the organization; others are only counted.
+type OrganizationFollow struct {
+	OrganizationID   int64     `json:"organization_id" gorm:"primaryKey"`
+	OrganizationName string    `json:"organization_name,omitempty" gorm:"->"`
+	VolunteerID      int64     `json:"volunteer_id" gorm:"primaryKey"`
+	ShareProfile     bool      `json:"share_profile"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+type FollowOrganizationInput struct {
+	ShareProfile bool `json:"share_profile"`
+}
+
+// OrganizationFollower is a follower who agreed to be seen by the
+// organization.
+type OrganizationFollower struct {
+	VolunteerID int64     `json:"volunteer_id"`
+	Name        string    `json:"name"`
+	FollowedAt  time.Time `json:"followed_at"`
+}
+
+// Announcement is news posted by an organization to its followers, or by an
+// admin to everyone when OrganizationID is nil.
+type Announcement struct {
+	ID               int64     `json:"id"`
+	OrganizationID   *int64    `json:"organization_id,omitempty"`
+	OrganizationName string    `json:"organization_name,omitempty" gorm:"->"`
+	Title            string    `json:"title"`
+	Body             string    `json:"body"`
+	CreatedBy        int64     `json:"created_by"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+type AnnouncementInput struct {
+	Title string `json:"title" binding:"required,max=255"`
+	Body  string `json:"body" binding:"required,max=5000"`
+}
+
+type FeedItemKind string
+
+const (
+	// FeedItemEvent is an event published by a followed organization.
+	FeedItemEvent FeedItemKind = "event"
+	// FeedItemAnnouncement is a followed organization's or the platform's
+	// announcement.
+	FeedItemAnnouncement FeedItemKind = "announcement"
+	// FeedItemAchievement and FeedItemLevelUp are the volunteer's own
+	// milestones.
+	FeedItemAchievement FeedItemKind = "achievement"
+	FeedItemLevelUp     FeedItemKind = "level_up"
+)
+
+// FeedItem is one entry in a volunteer's activity feed. ID is the ID of the
+// event, announcement, achievement or notification it shows.
+type FeedItem struct {
+	Kind             FeedItemKind `json:"kind"`
+	ID               int64        `json:"id"`
+	OccurredAt       time.Time    `json:"occurred_at"`
+	Title            string       `json:"title"`
+	Body             string       `json:"body,omitempty"`
+	OrganizationID   *int64       `json:"organization_id,omitempty"`
+	OrganizationName string       `json:"organization_name,omitempty"`
+	EventID          *int64       `json:"event_id,omitempty"`
+}
+
+// FeedCursor is the position of the last item on a feed page. Items are
+// ordered newest first, ties broken by kind and ID.
+type FeedCursor struct {
+	OccurredAt time.Time
+	Kind       FeedItemKind
+	ID         int64
+}
+
+// FeedPage is a page of the feed. NextCursor is empty on the last page.
+type FeedPage struct {
+	Items      []*FeedItem `json:"items"`
+	NextCursor string      `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,108 @@
+package models
+
+import "time"
+
+type FeedbackStatus string
+
+const (
+	FeedbackStatusVisible FeedbackStatus = "visible"
+	FeedbackStatusFlagged FeedbackStatus = "flagged" // awaiting moderation; hidden until approved
+	FeedbackStatusHidden  FeedbackStatus = "hidden"
+)
+
+// EventFeedback is a volunteer's rating of an event they took part in and of
+// the organization that hosted them. Moderation only affects the comment; the
+// ratings always count toward the averages.
+type EventFeedback struct {
+	ID                 int64          `json:"id"`
+	EventID            int64          `json:"event_id"`
+	VolunteerID        int64          `json:"volunteer_id"`
+	OrganizationID     int64          `json:"organization_id"` // the host the volunteer was attributed to
+	EventRating        int            `json:"event_rating"`
+	OrganizationRating int            `json:"organization_rating"`
+	Comment            string         `json:"comment,omitempty"`
+	Status             FeedbackStatus `json:"status"`
+	ReportCount        int            `json:"report_count,omitempty"`
+	ModerationNote     string         `json:"moderation_note,omitempty"`
+	ModeratedBy        *int64         `json:"moderated_by,omitempty"`
+	ModeratedAt        *time.Time     `json:"moderated_at,omitempty"`
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+}
+
+type SubmitFeedbackInput struct {
+	EventRating        int    `json:"event_rating" binding:"required,min=1,max=5"`
+	OrganizationRating int    `json:"organization_rating" binding:"required,min=1,max=5"`
+	Comment            string `json:"comment,omitempty" binding:"max=2000"`
+}
+
+type ModerateFeedbackInput struct {
+	Action string `json:"action" binding:"required,oneof=approve hide"`
+	Note   string `json:"note,omitempty"`
+}
+
+// EventParticipant is a volunteer who registered or signed up for an event and
+// did not cancel.
+type EventParticipant struct {
+	VolunteerID        int64
+	HostOrganizationID *int64
+}
+
+// VolunteerRating is an organization's private rating of a volunteer for one
+// event. Volunteers never see these.
+type VolunteerRating struct {
+	ID               int64     `json:"id"`
+	EventID          int64     `json:"event_id"`
+	EventTitle       string    `json:"event_title,omitempty" gorm:"->"`
+	VolunteerID      int64     `json:"volunteer_id"`
+	OrganizationID   int64     `json:"organization_id"`
+	OrganizationName string    `json:"organization_name,omitempty" gorm:"->"`
+	Rating           int       `json:"rating"`
+	Note             string    `json:"note,omitempty"`
+	RatedBy          int64     `json:"rated_by"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+type RateVolunteerInput struct {
+	Rating int    `json:"rating" binding:"required,min=1,max=5"`
+	Note   string `json:"note,omitempty" binding:"max=2000"`
+}
+
+type VolunteerRatingSummary struct {
+	VolunteerID   int64              `json:"volunteer_id"`
+	AverageRating float64            `json:"average_rating"`
+	RatingCount   int                `json:"rating_count"`
+	Ratings       []*VolunteerRating `json:"ratings"`
+}
+
+// FeedbackReport summarizes the feedback an organization received, optionally
+// limited to events in a date range.
+type FeedbackReport struct {
+	OrganizationID            int64                   `json:"organization_id"`
+	From                      *time.Time              `json:"from,omitempty"`
+	To                        *time.Time              `json:"to,omitempty"`
+	ResponseCount             int                     `json:"response_count"`
+	AverageEventRating        float64                 `json:"average_event_rating"`
+	AverageOrganizationRating float64                 `json:"average_organization_rating"`
+	RatingDistribution        map[int]int             `json:"rating_distribution"` // organization rating -> responses
+	Events                    []*EventFeedbackSummary `json:"events"`
+	RecentComments            []*EventFeedback        `json:"recent_comments"`
+}
+
+type EventFeedbackSummary struct {
+	EventID                   int64     `json:"event_id"`
+	Title                     string    `json:"title"`
+	Date                      time.Time `json:"date"`
+	Participants              int       `json:"participants"`
+	ResponseCount             int       `json:"response_count"`
+	ResponseRate              float64   `json:"response_rate"`
+	AverageEventRating        float64   `json:"average_event_rating"`
+	AverageOrganizationRating float64   `json:"average_organization_rating"`
+}
+
+// FeedbackReportFilter limits a report to events dated in [From, To).
+type FeedbackReportFilter struct {
+	From time.Time
+	To   time.Time
+}
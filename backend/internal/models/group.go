@@ -0,0 +1,154 @@
+package models
+
+import "time"
+
+type GroupKind string
+
+const (
+	GroupKindCorporate GroupKind = "corporate"
+	GroupKindSchool    GroupKind = "school"
+	GroupKindCommunity GroupKind = "community"
+	GroupKindOther     GroupKind = "other"
+)
+
+type GroupRole string
+
+const (
+	GroupRoleLeader GroupRole = "leader"
+	GroupRoleMember GroupRole = "member"
+)
+
+// VolunteerGroup is a team, such as a company team or a school club, whose
+// leaders sign members up for events together.
+type VolunteerGroup struct {
+	ID          int64          `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Kind        GroupKind      `json:"kind"`
+	CreatedBy   int64          `json:"created_by"` // volunteer ID of the founding leader
+	MemberCount int            `json:"member_count" gorm:"->"`
+	Members     []*GroupMember `json:"members,omitempty" gorm:"-"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+type GroupMember struct {
+	ID          int64     `json:"id"`
+	GroupID     int64     `json:"group_id"`
+	VolunteerID int64     `json:"volunteer_id"`
+	Name        string    `json:"name,omitempty" gorm:"->"`
+	Role        GroupRole `json:"role"`
+	JoinedAt    time.Time `json:"joined_at"`
+}
+
+type CreateGroupInput struct {
+	Name        string    `json:"name" binding:"required,max=255"`
+	Description string    `json:"description,omitempty"`
+	Kind        GroupKind `json:"kind" binding:"required,oneof=corporate school community other"`
+}
+
+type UpdateGroupInput struct {
+	Name        *string    `json:"name,omitempty" binding:"omitempty,max=255"`
+	Description *string    `json:"description,omitempty"`
+	Kind        *GroupKind `json:"kind,omitempty" binding:"omitempty,oneof=corporate school community other"`
+}
+
+type UpdateGroupMemberInput struct {
+	Role GroupRole `json:"role" binding:"required,oneof=leader member"`
+}
+
+type GroupInvitationStatus string
+
+const (
+	GroupInvitationPending  GroupInvitationStatus = "pending"
+	GroupInvitationAccepted GroupInvitationStatus = "accepted"
+	GroupInvitationRevoked  GroupInvitationStatus = "revoked"
+)
+
+// GroupInvitation invites someone by email, whether or not they have an
+// account yet. The token is only returned when the invitation is created;
+// the leader shares it and the invitee accepts it once signed in.
+type GroupInvitation struct {
+	ID         int64                 `json:"id"`
+	GroupID    int64                 `json:"group_id"`
+	Email      string                `json:"email"`
+	Token      string                `json:"token,omitempty" gorm:"-"`
+	TokenHash  string                `json:"-"`
+	Status     GroupInvitationStatus `json:"status"`
+	InvitedBy  int64                 `json:"invited_by"`
+	AcceptedBy *int64                `json:"accepted_by,omitempty"`
+	ExpiresAt  time.Time             `json:"expires_at"`
+	CreatedAt  time.Time             `json:"created_at"`
+	UpdatedAt  time.Time             `json:"updated_at"`
+}
+
+type InviteGroupMembersInput struct {
+	Emails []string `json:"emails" binding:"required,min=1,max=100,dive,email"`
+}
+
+type AcceptGroupInvitationInput struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type GroupSignupMode string
+
+const (
+	// GroupSignupAllOrNothing signs up every requested member or nobody
+	GroupSignupAllOrNothing GroupSignupMode = "all_or_nothing"
+	// GroupSignupPartial signs up the members who can go while seats last
+	GroupSignupPartial GroupSignupMode = "partial"
+)
+
+// GroupSignup records a leader signing group members up for an event.
+type GroupSignup struct {
+	ID      int64           `json:"id"`
+	GroupID int64           `json:"group_id"`
+	EventID int64           `json:"event_id"`
+	Mode    GroupSignupMode `json:"mode"`
+	// HostOrganizationID credits the members to one host of a co-hosted event
+	HostOrganizationID *int64               `json:"host_organization_id,omitempty"`
+	RequestedCount     int                  `json:"requested_count"`
+	SignedUpCount      int                  `json:"signed_up_count"`
+	CreatedBy          int64                `json:"created_by"` // volunteer ID of the leader
+	Members            []*GroupSignupMember `json:"members,omitempty" gorm:"-"`
+	CreatedAt          time.Time            `json:"created_at"`
+}
+
+type GroupSignupMemberStatus string
+
+const (
+	GroupSignupMemberSignedUp GroupSignupMemberStatus = "signed_up"
+	GroupSignupMemberSkipped  GroupSignupMemberStatus = "skipped"
+)
+
+// GroupSignupMember is the outcome of a group signup for one member, along
+// with the attendance the event's host recorded for them.
+type GroupSignupMember struct {
+	ID             int64                   `json:"id"`
+	GroupSignupID  int64                   `json:"group_signup_id"`
+	VolunteerID    int64                   `json:"volunteer_id"`
+	RegistrationID *int64                  `json:"registration_id,omitempty"`
+	Status         GroupSignupMemberStatus `json:"status"`
+	Reason         string                  `json:"reason,omitempty"`
+	Attendance     string                  `json:"attendance,omitempty"` // attended or no-show once recorded
+	HoursLogged    float64                 `json:"hours_logged,omitempty"`
+}
+
+type CreateGroupSignupInput struct {
+	EventID int64           `json:"event_id" binding:"required"`
+	Mode    GroupSignupMode `json:"mode" binding:"required,oneof=all_or_nothing partial"`
+	// MemberIDs are volunteer IDs; empty signs up every member
+	MemberIDs          []int64 `json:"member_ids,omitempty"`
+	HostOrganizationID *int64  `json:"host_organization_id,omitempty"`
+	AllowConflicts     bool    `json:"allow_conflicts,omitempty"`
+}
+
+type RecordGroupAttendanceInput struct {
+	Members []GroupAttendanceEntry `json:"members" binding:"required,min=1,dive"`
+}
+
+type GroupAttendanceEntry struct {
+	VolunteerID int64    `json:"volunteer_id" binding:"required"`
+	Status      string   `json:"status" binding:"required,oneof=attended no-show"`
+	HoursLogged *float64 `json:"hours_logged,omitempty" binding:"omitempty,min=0,max=24"`
+}
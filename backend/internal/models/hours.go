@@ -0,0 +1,75 @@
+package models
+
+import "time"
+
+type HoursStatus string
+
+const (
+	HoursStatusSubmitted HoursStatus = "submitted"
+	HoursStatusApproved  HoursStatus = "approved"
+	HoursStatusAdjusted  HoursStatus = "adjusted" // approved with different hours
+	HoursStatusRejected  HoursStatus = "rejected"
+	HoursStatusDisputed  HoursStatus = "disputed" // the volunteer contests an adjustment or rejection
+)
+
+type HoursAction string
+
+const (
+	HoursActionSubmitted HoursAction = "submitted"
+	HoursActionApproved  HoursAction = "approved"
+	HoursActionAdjusted  HoursAction = "adjusted"
+	HoursActionRejected  HoursAction = "rejected"
+	HoursActionDisputed  HoursAction = "disputed"
+	HoursActionRecorded  HoursAction = "recorded" // entered directly by the event's host
+)
+
+// HourSubmission is a volunteer's claim of hours worked at an event and the
+// host's decision on it. Only ApprovedHours count toward totals, transcripts
+// and school reports; they are mirrored onto the volunteer's event
+// registration. An adjustment under dispute keeps counting until the host
+// decides again.
+type HourSubmission struct {
+	ID             int64          `json:"id"`
+	EventID        int64          `json:"event_id"`
+	EventTitle     string         `json:"event_title,omitempty" gorm:"->"`
+	VolunteerID    int64          `json:"volunteer_id"`
+	VolunteerName  string         `json:"volunteer_name,omitempty" gorm:"->"`
+	OrganizationID int64          `json:"organization_id"` // the host the hours are credited to
+	Hours          float64        `json:"hours"`           // as claimed by the volunteer
+	ApprovedHours  *float64       `json:"approved_hours,omitempty"`
+	Status         HoursStatus    `json:"status"`
+	Note           string         `json:"note,omitempty"`
+	ReviewReason   string         `json:"review_reason,omitempty"`
+	ReviewedBy     *int64         `json:"reviewed_by,omitempty"`
+	ReviewedAt     *time.Time     `json:"reviewed_at,omitempty"`
+	DisputeReason  string         `json:"dispute_reason,omitempty"`
+	History        []*HoursChange `json:"history,omitempty" gorm:"-"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+}
+
+// HoursChange is one entry in the append-only history of a submission.
+type HoursChange struct {
+	ID           int64       `json:"id"`
+	SubmissionID int64       `json:"submission_id"`
+	Action       HoursAction `json:"action"`
+	Hours        *float64    `json:"hours,omitempty"`
+	Comment      string      `json:"comment,omitempty"`
+	ActorID      int64       `json:"actor_id"` // user who made the change
+	CreatedAt    time.Time   `json:"created_at"`
+}
+
+type SubmitHoursInput struct {
+	Hours float64 `json:"hours" binding:"required,gt=0,max=24"`
+	Note  string  `json:"note,omitempty" binding:"max=2000"`
+}
+
+type ReviewHoursInput struct {
+	Action string   `json:"action" binding:"required,oneof=approve adjust reject"`
+	Hours  *float64 `json:"hours,omitempty" binding:"omitempty,min=0,max=24"` // required to adjust
+	Reason string   `json:"reason,omitempty" binding:"max=2000"`              // required to adjust or reject
+}
+
+type DisputeHoursInput struct {
+	Reason string `json:"reason" binding:"required,max=2000"`
+}
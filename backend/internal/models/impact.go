@@ -0,0 +1,111 @@
+package models
+
+import (
+	"time"
+)
+
+// MetricAggregation decides how an impact metric's values are combined across
+// events, e.g. meals served are summed while a satisfaction score is averaged.
+type MetricAggregation string
+
+const (
+	MetricAggregationSum     MetricAggregation = "sum"
+	MetricAggregationAverage MetricAggregation = "average"
+	MetricAggregationMax     MetricAggregation = "max"
+	MetricAggregationMin     MetricAggregation = "min"
+)
+
+// ImpactMetricDefinition is an admin-defined kind of impact organizations can
+// record for their events. Categories limits it to events in those categories;
+// an empty list applies it to every category.
+type ImpactMetricDefinition struct {
+	ID          int64             `json:"id"`
+	Key         string            `json:"key"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Unit        string            `json:"unit"`
+	Aggregation MetricAggregation `json:"aggregation"`
+	Categories  []string          `json:"categories" gorm:"serializer:json"`
+	IsActive    bool              `json:"is_active"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+type CreateImpactMetricInput struct {
+	Key         string            `json:"key" binding:"required"`
+	Name        string            `json:"name" binding:"required"`
+	Description string            `json:"description,omitempty"`
+	Unit        string            `json:"unit" binding:"required"`
+	Aggregation MetricAggregation `json:"aggregation" binding:"required,oneof=sum average max min"`
+	Categories  []string          `json:"categories,omitempty"`
+}
+
+type UpdateImpactMetricInput struct {
+	Name        *string            `json:"name,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	Unit        *string            `json:"unit,omitempty"`
+	Aggregation *MetricAggregation `json:"aggregation,omitempty" binding:"omitempty,oneof=sum average max min"`
+	Categories  *[]string          `json:"categories,omitempty"`
+	IsActive    *bool              `json:"is_active,omitempty"`
+}
+
+// EventMetricValue is the value an organization recorded for one metric of an event.
+type EventMetricValue struct {
+	ID         int64     `json:"id"`
+	EventID    int64     `json:"event_id"`
+	MetricID   int64     `json:"metric_id"`
+	MetricKey  string    `json:"metric_key" gorm:"->"`
+	MetricName string    `json:"metric_name" gorm:"->"`
+	Unit       string    `json:"unit" gorm:"->"`
+	Value      float64   `json:"value"`
+	RecordedBy int64     `json:"recorded_by"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// RecordEventMetricsInput sets metric values for an event, keyed by metric
+// key. A null value removes a previously recorded value.
+type RecordEventMetricsInput struct {
+	Values map[string]*float64 `json:"values" binding:"required"`
+}
+
+// MetricRollupFilter narrows an impact rollup. Interval is "day", "week",
+// "month" or "year"; an empty interval returns totals only.
+type MetricRollupFilter struct {
+	OrganizationID *int64
+	Category       string
+	From           time.Time
+	To             time.Time
+	Interval       string
+}
+
+// MetricAggregate is one metric's raw aggregates, optionally for one period.
+type MetricAggregate struct {
+	MetricID    int64
+	Key         string
+	Name        string
+	Unit        string
+	Aggregation MetricAggregation
+	Period      *time.Time
+	Sum         float64
+	Average     float64
+	Max         float64
+	Min         float64
+	EventCount  int
+}
+
+type MetricRollup struct {
+	Key         string               `json:"key"`
+	Name        string               `json:"name"`
+	Unit        string               `json:"unit"`
+	Aggregation MetricAggregation    `json:"aggregation"`
+	Value       float64              `json:"value"`
+	EventCount  int                  `json:"event_count"`
+	Series      []*MetricRollupPoint `json:"series,omitempty"`
+}
+
+type MetricRollupPoint struct {
+	Period     time.Time `json:"period"`
+	Value      float64   `json:"value"`
+	EventCount int       `json:"event_count"`
+}
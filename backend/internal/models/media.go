@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+)
+
+// Media variant names. The original is re-encoded without metadata and capped
+// in size; the other variants are resized copies.
+const (
+	MediaVariantOriginal  = "original"
+	MediaVariantMedium    = "medium"
+	MediaVariantThumbnail = "thumbnail"
+)
+
+// Media is an uploaded image. Other records reference it by its stable Path,
+// e.g. Event.Image = "/media/42", which redirects signed-in users to a fresh
+// signed URL.
+type Media struct {
+	ID          int64                   `json:"id"`
+	UserID      int64                   `json:"user_id"`
+	ContentType string                  `json:"content_type"`
+	Size        int64                   `json:"size"`
+	Width       int                     `json:"width"`
+	Height      int                     `json:"height"`
+	Variants    map[string]MediaVariant `json:"variants" gorm:"serializer:json"`
+	Path        string                  `json:"path" gorm:"-"`
+	URLs        map[string]string       `json:"urls,omitempty" gorm:"-"`
+	CreatedAt   time.Time               `json:"created_at"`
+}
+
+type MediaVariant struct {
+	Key         string `json:"key"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+)
+
+type NotificationType string
+
+const (
+	NotificationEventCancelled            NotificationType = "event_cancelled"
+	NotificationEventApproved             NotificationType = "event_approved"
+	NotificationEventRejected             NotificationType = "event_rejected"
+	NotificationEventChangesRequested     NotificationType = "event_changes_requested"
+	NotificationCohostInvited             NotificationType = "cohost_invited"
+	NotificationCohostResponded           NotificationType = "cohost_responded"
+	NotificationFeedbackRequested         NotificationType = "feedback_requested"
+	NotificationGroupInvited              NotificationType = "group_invited"
+	NotificationGroupSignup               NotificationType = "group_signup"
+	NotificationHoursReviewed             NotificationType = "hours_reviewed"
+	NotificationHoursDisputed             NotificationType = "hours_disputed"
+	NotificationReliabilityReset          NotificationType = "reliability_reset"
+	NotificationReliabilityAppealResolved NotificationType = "reliability_appeal_resolved"
+	NotificationSignupApproved            NotificationType = "signup_approved"
+	NotificationSignupDeclined            NotificationType = "signup_declined"
+	NotificationBadgeAwarded              NotificationType = "badge_awarded"
+	NotificationLevelUp                   NotificationType = "level_up"
+	NotificationVerificationReviewed      NotificationType = "verification_reviewed"
+	NotificationSchoolLinkDecided         NotificationType = "school_link_decided"
+)
+
+type Notification struct {
+	ID        int64            `json:"id"`
+	UserID    int64            `json:"user_id"`
+	Type      NotificationType `json:"type"`
+	Title     string           `json:"title"`
+	Message   string           `json:"message,omitempty"`
+	EventID   *int64           `json:"event_id,omitempty"`
+	IsRead    bool             `json:"is_read"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+type CreateNotificationInput struct {
+	UserID  int64            `json:"user_id" binding:"required"`
+	Type    NotificationType `json:"type" binding:"required"`
+	Title   string           `json:"title" binding:"required"`
+	Message string           `json:"message,omitempty"`
+	EventID *int64           `json:"event_id,omitempty"`
+}
@@ -45,11 +45,20 @@ type Organization struct {
 	FoundingYear    int                `json:"founding_year,omitempty"`
 	TeamSize        string             `json:"team_size,omitempty"`
 	IsVerified      bool               `json:"is_verified"`
+	IsTrusted       bool               `json:"is_trusted"` // trusted organizations publish events without review
 	Status          OrganizationStatus `json:"status"`
 	TotalVolunteers int                `json:"total_volunteers,omitempty"`
 	TotalEvents     int                `json:"total_events,omitempty"`
-	CreatedAt       time.Time          `json:"created_at"`
-	UpdatedAt       time.Time          `json:"updated_at"`
+	RatingAverage   float64            `json:"rating_average"` // volunteers' average organization rating
+	RatingCount     int                `json:"rating_count"`
+	FollowerCount   int                `json:"follower_count"`
+
+	// Signup policy: volunteers scoring below MinReliabilityScore cannot sign
+	// up, and those below ApprovalBelowScore wait for approval
+	MinReliabilityScore *int      `json:"min_reliability_score,omitempty"`
+	ApprovalBelowScore  *int      `json:"approval_below_score,omitempty"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
 }
 
 type CreateOrganizationInput struct {
@@ -87,6 +96,10 @@ type UpdateOrganizationInput struct {
 	Status        *OrganizationStatus `json:"status,omitempty"`
 }
 
+type SetOrganizationTrustInput struct {
+	Trusted *bool `json:"trusted" binding:"required"`
+}
+
 type OrganizationStatsResponse struct {
 	TotalVolunteers  int `json:"total_volunteers"`
 	ActiveVolunteers int `json:"active_volunteers"`
@@ -95,4 +108,8 @@ type OrganizationStatsResponse struct {
 	PastEvents       int `json:"past_events"`
 	TotalHours       int `json:"total_hours"`
 	ImpactScore      int `json:"impact_score,omitempty"`
+	// Groups that signed members up for the organization's events, and the
+	// distinct volunteers those group signups brought
+	ParticipatingGroups int `json:"participating_groups"`
+	GroupVolunteers     int `json:"group_volunteers"`
 }
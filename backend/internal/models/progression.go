@@ -0,0 +1,60 @@
+package models
+
+import "time"
+
+// ProgressionStats is what XP is earned from: the volunteer's approved hours,
+// attended events and the months they were active in. Ratings organizations
+// gave are private, so they earn no XP that the volunteer could see.
+type ProgressionStats struct {
+	TotalHours     float64
+	EventsAttended int
+	ActiveMonths   []time.Time // first day of each month with an attended event, ascending
+}
+
+// VolunteerLevel is a volunteer's stored level, tagged with the version of the
+// XP rules it was computed under.
+type VolunteerLevel struct {
+	VolunteerID  int64 `gorm:"primaryKey"`
+	Level        int
+	XP           int
+	RulesVersion string
+	UpdatedAt    time.Time
+}
+
+// XPBreakdown splits a volunteer's XP by the rule that earned it.
+type XPBreakdown struct {
+	Hours  int `json:"hours"`
+	Events int `json:"events"`
+	Streak int `json:"streak"`
+}
+
+// VolunteerProgress is a volunteer's level and how far they are toward the
+// next one. NextLevelXP is omitted at the top level.
+type VolunteerProgress struct {
+	VolunteerID    int64       `json:"volunteer_id"`
+	Level          int         `json:"level"`
+	XP             int         `json:"xp"`
+	LevelXP        int         `json:"level_xp"`
+	NextLevelXP    *int        `json:"next_level_xp,omitempty"`
+	XPToNextLevel  int         `json:"xp_to_next_level"`
+	Progress       float64     `json:"progress"` // 0 to 1 through the current level
+	MaxLevel       bool        `json:"max_level"`
+	Breakdown      XPBreakdown `json:"breakdown"`
+	TotalHours     float64     `json:"total_hours"`
+	EventsAttended int         `json:"events_attended"`
+	LongestStreak  int         `json:"longest_streak"`
+}
+
+// LevelUp is published on the volunteer's topic when they reach a new level.
+type LevelUp struct {
+	VolunteerID   int64 `json:"volunteer_id"`
+	PreviousLevel int   `json:"previous_level"`
+	Level         int   `json:"level"`
+	XP            int   `json:"xp"`
+}
+
+// RecomputeLevelsResult reports a recompute of every volunteer's level.
+type RecomputeLevelsResult struct {
+	Volunteers int `json:"volunteers"`
+	Changed    int `json:"changed"`
+}
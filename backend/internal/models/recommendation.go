@@ -0,0 +1,18 @@
+package models
+
+// RecommendationScores breaks a recommendation down into its individual
+// signals, each in the range 0-1.
+type RecommendationScores struct {
+	Skills    float64 `json:"skills"`
+	Interests float64 `json:"interests"`
+	Location  float64 `json:"location"`
+	Schedule  float64 `json:"schedule"`
+	History   float64 `json:"history"`
+}
+
+type RecommendedEvent struct {
+	Event   *Event               `json:"event"`
+	Score   float64              `json:"score"`
+	Scores  RecommendationScores `json:"scores"`
+	Reasons []string             `json:"reasons"`
+}
@@ -0,0 +1,170 @@
+package models
+
+import (
+	"time"
+)
+
+// Registration statuses. A registration moves through them in one direction,
+// from applying to attending:
+//
+//	pending -> registered | declined | cancelled | cancelled-by-organizer
+//	registered -> cancelled | cancelled-by-organizer | attended | no-show
+//	attended <-> no-show (corrections by the host)
+//
+// A volunteer who applies again after a registration was declined or
+// cancelled reopens it as pending or registered.
+const (
+	RegistrationStatusPending              = "pending" // waiting for a coordinator's approval
+	RegistrationStatusRegistered           = "registered"
+	RegistrationStatusDeclined             = "declined"
+	RegistrationStatusCancelled            = "cancelled"
+	RegistrationStatusCancelledByOrganizer = "cancelled-by-organizer"
+	RegistrationStatusAttended             = "attended"
+	RegistrationStatusNoShow               = "no-show"
+)
+
+var registrationTransitions = map[string][]string{
+	RegistrationStatusPending: {
+		RegistrationStatusRegistered, RegistrationStatusDeclined,
+		RegistrationStatusCancelled, RegistrationStatusCancelledByOrganizer,
+	},
+	RegistrationStatusRegistered: {
+		RegistrationStatusCancelled, RegistrationStatusCancelledByOrganizer,
+		RegistrationStatusAttended, RegistrationStatusNoShow,
+	},
+	RegistrationStatusAttended: {RegistrationStatusNoShow},
+	RegistrationStatusNoShow:   {RegistrationStatusAttended},
+}
+
+// CanTransitionRegistration reports whether a registration may move from one
+// status to another.
+func CanTransitionRegistration(from, to string) bool {
+	for _, next := range registrationTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// RegistrationSeatStatuses are the statuses that take up one of the event's
+// seats.
+var RegistrationSeatStatuses = []string{
+	RegistrationStatusRegistered, RegistrationStatusAttended, RegistrationStatusNoShow,
+}
+
+// RegistrationReopenStatuses are the statuses a registration can be reopened
+// from when the volunteer applies again.
+var RegistrationReopenStatuses = []string{
+	RegistrationStatusDeclined, RegistrationStatusCancelled, RegistrationStatusCancelledByOrganizer,
+}
+
+// EventRegistration is a volunteer's commitment to an event, from the
+// application through attendance and the hours credited for it.
+type EventRegistration struct {
+	ID                 int64                  `json:"id"`
+	EventID            int64                  `json:"event_id"`
+	VolunteerID        int64                  `json:"volunteer_id"`
+	Status             string                 `json:"status"`
+	HostOrganizationID *int64                 `json:"host_organization_id,omitempty"` // nil credits the primary organization
+	GroupSignupID      *int64                 `json:"group_signup_id,omitempty"`      // set when a group leader registered the volunteer
+	FormID             *int64                 `json:"form_id,omitempty"`
+	Answers            map[string]interface{} `json:"answers,omitempty" gorm:"serializer:json"`
+	HoursLogged        float64                `json:"hours_logged,omitempty"` // approved hours only
+	RegistrationDate   time.Time              `json:"registration_date"`
+	// The coordinator's decision on a registration that waited for approval
+	DecisionMessage string     `json:"decision_message,omitempty"`
+	DecidedBy       *int64     `json:"decided_by,omitempty"`
+	DecidedAt       *time.Time `json:"decided_at,omitempty"`
+	CancelledAt     *time.Time `json:"cancelled_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// HoldsSeat reports whether the registration takes up one of the event's
+// seats.
+func (r *EventRegistration) HoldsSeat() bool {
+	for _, status := range RegistrationSeatStatuses {
+		if r.Status == status {
+			return true
+		}
+	}
+	return false
+}
+
+// CanReopen reports whether the volunteer may apply again on this
+// registration.
+func (r *EventRegistration) CanReopen() bool {
+	for _, status := range RegistrationReopenStatuses {
+		if r.Status == status {
+			return true
+		}
+	}
+	return false
+}
+
+// RegistrationUpdate is a registration as it is broadcast to websocket
+// subscribers. Anyone can subscribe to an event's topic, so the volunteer's
+// answers and the coordinator's message are left out.
+type RegistrationUpdate struct {
+	ID                 int64      `json:"id"`
+	EventID            int64      `json:"event_id"`
+	VolunteerID        int64      `json:"volunteer_id"`
+	Status             string     `json:"status"`
+	HostOrganizationID *int64     `json:"host_organization_id,omitempty"`
+	GroupSignupID      *int64     `json:"group_signup_id,omitempty"`
+	RegistrationDate   time.Time  `json:"registration_date"`
+	CancelledAt        *time.Time `json:"cancelled_at,omitempty"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+}
+
+// NewRegistrationUpdate presents a registration for broadcast.
+func NewRegistrationUpdate(registration *EventRegistration) *RegistrationUpdate {
+	return &RegistrationUpdate{
+		ID:                 registration.ID,
+		EventID:            registration.EventID,
+		VolunteerID:        registration.VolunteerID,
+		Status:             registration.Status,
+		HostOrganizationID: registration.HostOrganizationID,
+		GroupSignupID:      registration.GroupSignupID,
+		RegistrationDate:   registration.RegistrationDate,
+		CancelledAt:        registration.CancelledAt,
+		UpdatedAt:          registration.UpdatedAt,
+	}
+}
+
+type CreateEventRegistrationInput struct {
+	EventID     int64  `json:"event_id" binding:"required"`
+	VolunteerID int64  `json:"volunteer_id" binding:"required"`
+	Status      string `json:"-"` // set by the service from the event's approval mode
+
+	// HostOrganizationID attributes the volunteer to one host of a co-hosted event
+	HostOrganizationID *int64 `json:"host_organization_id,omitempty"`
+
+	// Answers to the event's registration questions, keyed by question ID
+	Answers map[string]interface{} `json:"answers,omitempty"`
+	FormID  *int64                 `json:"-"` // set by the service to the form version answered
+
+	// Waiver is required when the event has a waiver the volunteer has not
+	// signed in its current version
+	Waiver   *SignWaiverInput `json:"waiver,omitempty"`
+	SignerIP string           `json:"-"` // set by the handler from the request
+
+	// AllowConflicts confirms the registration although it overlaps the
+	// volunteer's other commitments; honored only when the conflict policy is
+	// "warn"
+	AllowConflicts bool `json:"allow_conflicts,omitempty"`
+}
+
+// UpdateEventRegistrationInput lets a volunteer cancel a registration.
+// Approvals, attendance and hours each go through their own workflow.
+type UpdateEventRegistrationInput struct {
+	Status *string `json:"status,omitempty" binding:"omitempty,oneof=cancelled"`
+}
+
+// RegistrationDecisionInput is a coordinator's approval or decline of a
+// pending registration, with an optional message to the volunteer.
+type RegistrationDecisionInput struct {
+	Action  string `json:"action" binding:"required,oneof=approve decline"`
+	Message string `json:"message,omitempty"`
+}
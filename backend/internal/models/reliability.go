@@ -0,0 +1,89 @@
+package models
+
+import "time"
+
+type ReliabilityOutcome string
+
+const (
+	ReliabilityOutcomeAttended         ReliabilityOutcome = "attended"
+	ReliabilityOutcomeNoShow           ReliabilityOutcome = "no-show"
+	ReliabilityOutcomeLateCancellation ReliabilityOutcome = "late-cancellation"
+)
+
+type ReliabilityAppealStatus string
+
+const (
+	ReliabilityAppealPending  ReliabilityAppealStatus = "pending"
+	ReliabilityAppealAccepted ReliabilityAppealStatus = "accepted" // the event no longer counts against the volunteer
+	ReliabilityAppealRejected ReliabilityAppealStatus = "rejected"
+)
+
+// ReliabilityRecord is one event counted in a volunteer's reliability score.
+type ReliabilityRecord struct {
+	EventID    int64              `json:"event_id"`
+	EventTitle string             `json:"event_title"`
+	StartTime  time.Time          `json:"start_time"`
+	Outcome    ReliabilityOutcome `json:"outcome"`
+	Excused    bool               `json:"excused,omitempty"` // excluded after an accepted appeal
+}
+
+// ReliabilityScore summarizes how reliably a volunteer shows up. Score is nil
+// until enough events count; signup policies do not apply before then.
+type ReliabilityScore struct {
+	VolunteerID       int64                `json:"volunteer_id"`
+	Score             *int                 `json:"score"` // 0-100
+	Attended          int                  `json:"attended"`
+	NoShows           int                  `json:"no_shows"`
+	LateCancellations int                  `json:"late_cancellations"`
+	Excused           int                  `json:"excused"`
+	Since             time.Time            `json:"since"`              // start of the window, or the last reset
+	ResetAt           *time.Time           `json:"reset_at,omitempty"` // last time an admin reset the score
+	Explanation       []string             `json:"explanation"`
+	Records           []*ReliabilityRecord `json:"records,omitempty"`
+}
+
+// ReliabilityAppeal asks an admin to excuse one no-show or late cancellation.
+type ReliabilityAppeal struct {
+	ID             int64                   `json:"id"`
+	VolunteerID    int64                   `json:"volunteer_id"`
+	EventID        int64                   `json:"event_id"`
+	EventTitle     string                  `json:"event_title,omitempty" gorm:"->"`
+	Reason         string                  `json:"reason"`
+	Status         ReliabilityAppealStatus `json:"status"`
+	ResolutionNote string                  `json:"resolution_note,omitempty"`
+	ResolvedBy     *int64                  `json:"resolved_by,omitempty"`
+	ResolvedAt     *time.Time              `json:"resolved_at,omitempty"`
+	CreatedAt      time.Time               `json:"created_at"`
+	UpdatedAt      time.Time               `json:"updated_at"`
+}
+
+// ReliabilityReset records an admin clearing a volunteer's history; only
+// events after the latest reset count.
+type ReliabilityReset struct {
+	ID          int64     `json:"id"`
+	VolunteerID int64     `json:"volunteer_id"`
+	Reason      string    `json:"reason"`
+	ResetBy     int64     `json:"reset_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type CreateReliabilityAppealInput struct {
+	EventID int64  `json:"event_id" binding:"required"`
+	Reason  string `json:"reason" binding:"required,max=2000"`
+}
+
+type ResolveReliabilityAppealInput struct {
+	Action string `json:"action" binding:"required,oneof=accept reject"`
+	Note   string `json:"note,omitempty" binding:"max=2000"`
+}
+
+type ResetReliabilityInput struct {
+	Reason string `json:"reason" binding:"required,max=2000"`
+}
+
+// SetSignupPolicyInput sets an organization's reliability requirements for
+// signups to its events. Nil clears a requirement.
+type SetSignupPolicyInput struct {
+	MinReliabilityScore *int `json:"min_reliability_score" binding:"omitempty,min=0,max=100"`
+	ApprovalBelowScore  *int `json:"approval_below_score" binding:"omitempty,min=0,max=100"`
+}
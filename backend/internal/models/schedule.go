@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// ScheduleEntry is an event a volunteer is registered for or attended.
+type ScheduleEntry struct {
+	EventID   int64     `json:"event_id"`
+	Title     string    `json:"title"`
+	Location  string    `json:"location,omitempty"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	TimeZone  string    `json:"time_zone"`
+	Status    string    `json:"status"`
+	// ConflictsWith lists the other entries whose times, padded by the travel
+	// buffer, overlap this one
+	ConflictsWith []int64 `json:"conflicts_with,omitempty" gorm:"-"`
+}
+
+// Schedule is a volunteer's commitments in a time window.
+type Schedule struct {
+	From         time.Time        `json:"from"`
+	To           time.Time        `json:"to"`
+	TravelBuffer string           `json:"travel_buffer"`
+	Entries      []*ScheduleEntry `json:"entries"`
+	Conflicts    int              `json:"conflicts"` // entries that overlap at least one other
+}
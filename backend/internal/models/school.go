@@ -0,0 +1,102 @@
+package models
+
+import "time"
+
+// School is a school or service-hour program. Its school admin approves the
+// students who link to it and tracks their hours against each term's
+// requirement.
+type School struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Location    string    `json:"location,omitempty"`
+	Website     string    `json:"website,omitempty"`
+	UserID      int64     `json:"user_id"` // the school admin
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// SchoolInput creates or updates a school. Only admins assign the school
+// admin, who must have the school_admin role.
+type SchoolInput struct {
+	Name        string `json:"name" binding:"required,max=255"`
+	Description string `json:"description,omitempty"`
+	Location    string `json:"location,omitempty" binding:"max=255"`
+	Website     string `json:"website,omitempty" binding:"omitempty,url"`
+	UserID      *int64 `json:"user_id,omitempty"`
+}
+
+// SchoolTerm is a period, inclusive of both dates, over which students must
+// serve RequiredHours. Hours count toward the term their event falls in.
+type SchoolTerm struct {
+	ID            int64     `json:"id"`
+	SchoolID      int64     `json:"school_id"`
+	Name          string    `json:"name"`
+	StartDate     time.Time `json:"start_date" gorm:"type:date"`
+	EndDate       time.Time `json:"end_date" gorm:"type:date"`
+	RequiredHours float64   `json:"required_hours"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type SchoolTermInput struct {
+	Name          string  `json:"name" binding:"required,max=100"`
+	StartDate     string  `json:"start_date" binding:"required"` // YYYY-MM-DD
+	EndDate       string  `json:"end_date" binding:"required"`
+	RequiredHours float64 `json:"required_hours" binding:"min=0"`
+}
+
+type SchoolStudentStatus string
+
+const (
+	SchoolStudentPending  SchoolStudentStatus = "pending"
+	SchoolStudentApproved SchoolStudentStatus = "approved"
+	SchoolStudentRejected SchoolStudentStatus = "rejected"
+)
+
+// SchoolStudent links a volunteer to a school. The school admin must approve
+// the link before the school sees the student's hours.
+type SchoolStudent struct {
+	ID            int64               `json:"id"`
+	SchoolID      int64               `json:"school_id"`
+	SchoolName    string              `json:"school_name,omitempty" gorm:"->"`
+	VolunteerID   int64               `json:"volunteer_id"`
+	VolunteerName string              `json:"volunteer_name,omitempty" gorm:"->"`
+	StudentNumber string              `json:"student_number,omitempty"`
+	Status        SchoolStudentStatus `json:"status"`
+	DecisionNote  string              `json:"decision_note,omitempty"`
+	DecidedBy     *int64              `json:"decided_by,omitempty"`
+	DecidedAt     *time.Time          `json:"decided_at,omitempty"`
+	CreatedAt     time.Time           `json:"created_at"`
+	UpdatedAt     time.Time           `json:"updated_at"`
+}
+
+type LinkSchoolInput struct {
+	StudentNumber string `json:"student_number,omitempty" binding:"max=50"`
+}
+
+type DecideSchoolStudentInput struct {
+	Decision string `json:"decision" binding:"required,oneof=approve reject"`
+	Note     string `json:"note,omitempty" binding:"max=1000"`
+}
+
+// StudentProgress is one student's approved hours in a term measured against
+// the term's requirement.
+type StudentProgress struct {
+	VolunteerID    int64   `json:"volunteer_id"`
+	Name           string  `json:"name"`
+	Email          string  `json:"email"`
+	StudentNumber  string  `json:"student_number,omitempty"`
+	ApprovedHours  float64 `json:"approved_hours"`
+	RequiredHours  float64 `json:"required_hours"`
+	RemainingHours float64 `json:"remaining_hours"`
+	Complete       bool    `json:"complete"`
+}
+
+// SchoolTermReport is the school admin's dashboard for one term.
+type SchoolTermReport struct {
+	School    *School            `json:"school"`
+	Term      *SchoolTerm        `json:"term"`
+	Students  []*StudentProgress `json:"students"`
+	Completed int                `json:"completed"`
+}
@@ -4,29 +4,84 @@ import (
 	"time"
 )
 
+// SignupStatus is a registration status as the /api/signups endpoints report
+// it: a registration holding a seat before the event is "confirmed".
 type SignupStatus string
 
 const (
-	SignupStatusPending   SignupStatus = "pending"
+	SignupStatusPending   SignupStatus = RegistrationStatusPending
 	SignupStatusConfirmed SignupStatus = "confirmed"
-	SignupStatusCancelled SignupStatus = "cancelled"
+	SignupStatusCancelled SignupStatus = RegistrationStatusCancelled
+	SignupStatusDeclined  SignupStatus = RegistrationStatusDeclined
+	SignupStatusAttended  SignupStatus = RegistrationStatusAttended
+	SignupStatusNoShow    SignupStatus = RegistrationStatusNoShow
+
+	// SignupStatusCancelledByOrganizer is set by the system when the event
+	// itself is cancelled; clients cannot request it.
+	SignupStatusCancelledByOrganizer SignupStatus = RegistrationStatusCancelledByOrganizer
 )
 
+// Signup is an event registration in the shape the /api/signups endpoints
+// have always returned. Signups and registrations share IDs.
 type Signup struct {
 	ID          int64        `json:"id"`
 	EventID     int64        `json:"event_id"`
 	VolunteerID int64        `json:"volunteer_id"`
 	Status      SignupStatus `json:"status"`
-	CreatedAt   time.Time    `json:"created_at"`
-	UpdatedAt   time.Time    `json:"updated_at"`
+	// HostOrganizationID credits the signup to one host of a co-hosted event;
+	// nil means the event's primary organization
+	HostOrganizationID *int64                 `json:"host_organization_id,omitempty"`
+	GroupSignupID      *int64                 `json:"group_signup_id,omitempty"` // set when a group leader signed the volunteer up
+	FormID             *int64                 `json:"form_id,omitempty"`
+	Answers            map[string]interface{} `json:"answers,omitempty"`
+	CancelledAt        *time.Time             `json:"cancelled_at,omitempty"`
+	// The coordinator's decision on a signup that waited for approval
+	DecisionMessage string     `json:"decision_message,omitempty"`
+	DecidedBy       *int64     `json:"decided_by,omitempty"`
+	DecidedAt       *time.Time `json:"decided_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
 }
 
-type CreateSignupInput struct {
-	EventID     int64        `json:"event_id" binding:"required"`
-	VolunteerID int64        `json:"volunteer_id" binding:"required"`
-	Status      SignupStatus `json:"status" binding:"required,oneof=pending confirmed cancelled"`
+// NewSignup presents a registration as a signup.
+func NewSignup(registration *EventRegistration) *Signup {
+	status := SignupStatus(registration.Status)
+	if registration.Status == RegistrationStatusRegistered {
+		status = SignupStatusConfirmed
+	}
+
+	return &Signup{
+		ID:                 registration.ID,
+		EventID:            registration.EventID,
+		VolunteerID:        registration.VolunteerID,
+		Status:             status,
+		HostOrganizationID: registration.HostOrganizationID,
+		GroupSignupID:      registration.GroupSignupID,
+		FormID:             registration.FormID,
+		Answers:            registration.Answers,
+		CancelledAt:        registration.CancelledAt,
+		DecisionMessage:    registration.DecisionMessage,
+		DecidedBy:          registration.DecidedBy,
+		DecidedAt:          registration.DecidedAt,
+		CreatedAt:          registration.CreatedAt,
+		UpdatedAt:          registration.UpdatedAt,
+	}
 }
 
-type UpdateSignupInput struct {
-	Status *SignupStatus `json:"status,omitempty" binding:"omitempty,oneof=pending confirmed cancelled"`
+// NewSignups presents registrations as signups.
+func NewSignups(registrations []*EventRegistration) []*Signup {
+	signups := make([]*Signup, len(registrations))
+	for i, registration := range registrations {
+		signups[i] = NewSignup(registration)
+	}
+	return signups
 }
+
+// CreateSignupInput is the body of POST /api/signups.
+type CreateSignupInput = CreateEventRegistrationInput
+
+// UpdateSignupInput is the body of PUT /api/signups/:id.
+type UpdateSignupInput = UpdateEventRegistrationInput
+
+// SignupDecisionInput is the body of PUT /api/signups/:id/decision.
+type SignupDecisionInput = RegistrationDecisionInput
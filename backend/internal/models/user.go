@@ -10,6 +10,7 @@ const (
 	RoleAdmin        Role = "admin"
 	RoleVolunteer    Role = "volunteer"
 	RoleOrganization Role = "organization"
+	RoleSchoolAdmin  Role = "school_admin"
 )
 
 type User struct {
@@ -31,7 +32,7 @@ type CreateUserInput struct {
 	Name     string `json:"name" binding:"required"`
 	Email    string `json:"email" binding:"required,email"`
 	Password string `json:"password" binding:"required,min=6"`
-	Role     Role   `json:"role" binding:"required,oneof=admin volunteer organization"`
+	Role     Role   `json:"role" binding:"required,oneof=admin volunteer organization school_admin"`
 	Avatar   string `json:"avatar,omitempty"`
 	Phone    string `json:"phone,omitempty"`
 	Location string `json:"location,omitempty"`
@@ -62,7 +63,7 @@ type UserResponse struct {
 type LoginRequest struct {
 	Email    string `json:"email" binding:"required,email"`
 	Password string `json:"password" binding:"required"`
-	Role     Role   `json:"role" binding:"required,oneof=admin volunteer organization"`
+	Role     Role   `json:"role" binding:"required,oneof=admin volunteer organization school_admin"`
 }
 
 type LoginResponse struct {
@@ -0,0 +1,71 @@
+package models
+
+import "time"
+
+type VerificationDocumentType string
+
+const (
+	VerificationDocumentID              VerificationDocumentType = "id_document"
+	VerificationDocumentBackgroundCheck VerificationDocumentType = "background_check"
+	VerificationDocumentCertification   VerificationDocumentType = "certification"
+)
+
+type VerificationDocumentStatus string
+
+const (
+	VerificationDocumentPending  VerificationDocumentStatus = "pending"
+	VerificationDocumentApproved VerificationDocumentStatus = "approved"
+	VerificationDocumentRejected VerificationDocumentStatus = "rejected"
+)
+
+// VerificationDocument is a file a volunteer uploaded to prove who they are
+// or what they are cleared for. It is kept in private storage and only
+// downloaded through the API. An approved document grants its Level until
+// it expires.
+type VerificationDocument struct {
+	ID                     int64                      `json:"id"`
+	VolunteerID            int64                      `json:"volunteer_id"`
+	Type                   VerificationDocumentType   `json:"type"`
+	FileName               string                     `json:"file_name"`
+	ContentType            string                     `json:"content_type"`
+	Size                   int64                      `json:"size"`
+	StorageKey             string                     `json:"-"`
+	Status                 VerificationDocumentStatus `json:"status"`
+	Level                  VerificationLevel          `json:"level,omitempty"` // granted on approval
+	ExpiresAt              *time.Time                 `json:"expires_at,omitempty"`
+	ReviewNote             string                     `json:"review_note,omitempty"`
+	ReviewedBy             *int64                     `json:"reviewed_by,omitempty"`
+	ReviewerOrganizationID *int64                     `json:"reviewer_organization_id,omitempty"` // set when an organization reviewed it
+	ReviewedAt             *time.Time                 `json:"reviewed_at,omitempty"`
+	CreatedAt              time.Time                  `json:"created_at"`
+	UpdatedAt              time.Time                  `json:"updated_at"`
+}
+
+// Expired reports whether the document's certification has lapsed at t.
+func (d *VerificationDocument) Expired(t time.Time) bool {
+	return d.ExpiresAt != nil && !t.Before(*d.ExpiresAt)
+}
+
+// VerificationStatus is a volunteer's current verification level and the
+// documents behind it. Level only counts approved documents that have not
+// expired.
+type VerificationStatus struct {
+	VolunteerID int64                   `json:"volunteer_id"`
+	Level       VerificationLevel       `json:"level"`
+	ExpiresAt   *time.Time              `json:"expires_at,omitempty"` // when the current level lapses
+	Documents   []*VerificationDocument `json:"documents"`
+}
+
+type UploadVerificationDocumentInput struct {
+	Type      VerificationDocumentType `form:"type" binding:"required,oneof=id_document background_check certification"`
+	ExpiresAt *time.Time               `form:"expires_at" time_format:"2006-01-02"`
+}
+
+// ReviewVerificationDocumentInput approves a document at a level, optionally
+// until an expiry date, or rejects it with a note.
+type ReviewVerificationDocumentInput struct {
+	Decision  string            `json:"decision" binding:"required,oneof=approve reject"`
+	Level     VerificationLevel `json:"level,omitempty" binding:"omitempty,oneof=basic verified trusted"`
+	ExpiresAt *time.Time        `json:"expires_at,omitempty"`
+	Note      string            `json:"note,omitempty" binding:"max=1000"`
+}
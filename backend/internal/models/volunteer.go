@@ -13,6 +13,20 @@ const (
 	VerificationTrusted  VerificationLevel = "trusted"
 )
 
+// verificationRanks orders the verification levels from lowest to highest.
+var verificationRanks = map[VerificationLevel]int{
+	VerificationNone:     0,
+	VerificationBasic:    1,
+	VerificationVerified: 2,
+	VerificationTrusted:  3,
+}
+
+// Meets reports whether the level is at least the required one. An empty
+// level counts as none.
+func (l VerificationLevel) Meets(required VerificationLevel) bool {
+	return verificationRanks[l] >= verificationRanks[required]
+}
+
 type VolunteerStatus string
 
 const (
@@ -26,11 +40,12 @@ type Volunteer struct {
 	UserID            int64             `json:"user_id"`
 	Skills            []string          `json:"skills"`
 	Interests         []string          `json:"interests"`
-	Availability      string            `json:"availability"`
+	Availability      string            `json:"availability"` // free text; scheduling uses the weekly windows
+	TimeZone          string            `json:"time_zone"`    // IANA name the availability windows are in
 	Bio               string            `json:"bio"`
 	Location          string            `json:"location"`
 	Languages         []string          `json:"languages,omitempty"`
-	TotalHours        float64           `json:"total_hours"`
+	TotalHours        float64           `json:"total_hours"` // sum of approved hours
 	EventsAttended    int               `json:"events_attended"`
 	VerificationLevel VerificationLevel `json:"verification_level"`
 	Status            VolunteerStatus   `json:"status"`
@@ -51,17 +66,15 @@ type CreateVolunteerInput struct {
 }
 
 type UpdateVolunteerInput struct {
-	Skills            *[]string          `json:"skills,omitempty"`
-	Interests         *[]string          `json:"interests,omitempty"`
-	Availability      *string            `json:"availability,omitempty"`
-	Bio               *string            `json:"bio,omitempty"`
-	Location          *string            `json:"location,omitempty"`
-	Languages         *[]string          `json:"languages,omitempty"`
-	TotalHours        *float64           `json:"total_hours,omitempty"`
-	EventsAttended    *int               `json:"events_attended,omitempty"`
-	VerificationLevel *VerificationLevel `json:"verification_level,omitempty"`
-	Status            *VolunteerStatus   `json:"status,omitempty"`
-	LastActive        *time.Time         `json:"last_active,omitempty"`
+	Skills         *[]string        `json:"skills,omitempty"`
+	Interests      *[]string        `json:"interests,omitempty"`
+	Availability   *string          `json:"availability,omitempty"`
+	Bio            *string          `json:"bio,omitempty"`
+	Location       *string          `json:"location,omitempty"`
+	Languages      *[]string        `json:"languages,omitempty"`
+	EventsAttended *int             `json:"events_attended,omitempty"`
+	Status         *VolunteerStatus `json:"status,omitempty"`
+	LastActive     *time.Time       `json:"last_active,omitempty"`
 }
 
 type VolunteerStatsResponse struct {
@@ -72,12 +85,16 @@ type VolunteerStatsResponse struct {
 	Achievements   int     `json:"achievements,omitempty"`
 }
 
+// Achievement is a badge awarded to a volunteer. Badges awarded per
+// organization carry the organization they were earned with.
 type Achievement struct {
-	ID          int64     `json:"id"`
-	VolunteerID int64     `json:"volunteer_id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	AwardedDate time.Time `json:"awarded_date"`
-	Icon        string    `json:"icon,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID             int64     `json:"id"`
+	VolunteerID    int64     `json:"volunteer_id"`
+	BadgeID        *int64    `json:"badge_id,omitempty"`
+	OrganizationID *int64    `json:"organization_id,omitempty"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	AwardedDate    time.Time `json:"awarded_date"`
+	Icon           string    `json:"icon,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
 }
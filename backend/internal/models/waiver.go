@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+)
+
+// Waiver is an organization's liability waiver. Its text lives in versions so
+// that signatures always point at the exact wording that was signed.
+type Waiver struct {
+	ID             int64          `json:"id"`
+	OrganizationID int64          `json:"organization_id"`
+	Title          string         `json:"title"`
+	CurrentVersion *WaiverVersion `json:"current_version,omitempty" gorm:"-"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+}
+
+type WaiverVersion struct {
+	ID        int64     `json:"id"`
+	WaiverID  int64     `json:"waiver_id"`
+	Version   int       `json:"version"`
+	Text      string    `json:"text"`
+	TextHash  string    `json:"text_hash"` // hex SHA-256 of Text
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type WaiverSignature struct {
+	ID              int64     `json:"id"`
+	WaiverID        int64     `json:"waiver_id"`
+	WaiverVersionID int64     `json:"waiver_version_id"`
+	VolunteerID     int64     `json:"volunteer_id"`
+	EventID         int64     `json:"event_id"`
+	SignedName      string    `json:"signed_name"`
+	SignedAt        time.Time `json:"signed_at"`
+	IPAddress       string    `json:"ip_address"`
+	TextHash        string    `json:"text_hash"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+type CreateWaiverInput struct {
+	Title string `json:"title" binding:"required"`
+	Text  string `json:"text" binding:"required"`
+}
+
+type CreateWaiverVersionInput struct {
+	Text string `json:"text" binding:"required"`
+}
+
+type AttachWaiverInput struct {
+	WaiverID *int64 `json:"waiver_id"` // null detaches the waiver
+}
+
+// SignWaiverInput is the volunteer's e-signature, sent with a signup when the
+// event requires a waiver they have not signed yet.
+type SignWaiverInput struct {
+	SignedName string `json:"signed_name" binding:"required"`
+	Agree      bool   `json:"agree"`
+}
+
+// EventWaiverResponse tells a volunteer which waiver an event needs and
+// whether they still have to sign it.
+type EventWaiverResponse struct {
+	Waiver       *Waiver        `json:"waiver"`
+	Version      *WaiverVersion `json:"version"`
+	NeedsSigning bool           `json:"needs_signing"`
+}
@@ -204,7 +204,7 @@ func (r *AnalyticsRepository) GetUpcomingEvents(ctx context.Context, limit int)
 			COUNT(er.id) as registrations
 		FROM events e
 		JOIN organizations o ON e.organization_id = o.id
-		LEFT JOIN event_registrations er ON e.id = er.event_id
+		LEFT JOIN event_registrations er ON e.id = er.event_id AND er.status = 'registered'
 		WHERE e.date >= ? AND e.status = 'active'
 		GROUP BY e.id, o.name
 		ORDER BY registrations DESC
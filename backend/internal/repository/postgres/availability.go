@@ -0,0 +1,192 @@
+package postgres
+
+import (
+	"context"
+	"time"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// shiftLocalTimes puts the shift's start and end on each volunteer's wall
+// clock, with the last day the shift touches.
+const shiftLocalTimes = `CROSS JOIN LATERAL (
+		SELECT s.starts, s.ends, (s.ends - INTERVAL '1 second')::date AS last_day
+		FROM (SELECT CAST(? AS timestamptz) AT TIME ZONE v.time_zone AS starts,
+			CAST(? AS timestamptz) AT TIME ZONE v.time_zone AS ends) s
+	) l`
+
+// shiftCovered requires a weekly window covering the shift on the day it
+// starts, running to midnight when the shift ends the next day, and one from
+// midnight covering the rest.
+const shiftCovered = `EXISTS (
+		SELECT 1 FROM availability_windows w
+		WHERE w.volunteer_id = v.id
+			AND w.weekday = EXTRACT(DOW FROM l.starts)
+			AND w.start_time <= to_char(l.starts, 'HH24:MI')
+			AND w.end_time >= CASE WHEN l.ends::date > l.starts::date THEN '24:00' ELSE to_char(l.ends, 'HH24:MI') END
+	) AND (l.last_day = l.starts::date OR EXISTS (
+		SELECT 1 FROM availability_windows w
+		WHERE w.volunteer_id = v.id
+			AND w.weekday = EXTRACT(DOW FROM l.ends)
+			AND w.start_time = '00:00'
+			AND w.end_time >= to_char(l.ends, 'HH24:MI')
+	))`
+
+// shiftNotBlackedOut excludes volunteers away on any day the shift touches.
+const shiftNotBlackedOut = `NOT EXISTS (
+		SELECT 1 FROM availability_blackouts b
+		WHERE b.volunteer_id = v.id AND b.start_date <= l.last_day AND b.end_date >= l.starts::date
+	)`
+
+type AvailabilityRepository struct {
+	db *gorm.DB
+}
+
+func NewAvailabilityRepository(db *gorm.DB) *AvailabilityRepository {
+	return &AvailabilityRepository{db: db}
+}
+
+func (r *AvailabilityRepository) SetTimeZone(ctx context.Context, volunteerID int64, timeZone string) error {
+	return r.db.WithContext(ctx).
+		Model(&models.Volunteer{}).
+		Where("id = ?", volunteerID).
+		Updates(map[string]interface{}{
+			"time_zone":  timeZone,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+func (r *AvailabilityRepository) ListWindows(ctx context.Context, volunteerID int64) ([]*models.AvailabilityWindow, error) {
+	var windows []*models.AvailabilityWindow
+	result := r.db.WithContext(ctx).
+		Where("volunteer_id = ?", volunteerID).
+		Order("weekday, start_time").
+		Find(&windows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return windows, nil
+}
+
+func (r *AvailabilityRepository) GetWindow(ctx context.Context, id int64) (*models.AvailabilityWindow, error) {
+	var window models.AvailabilityWindow
+	result := r.db.WithContext(ctx).First(&window, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &window, nil
+}
+
+func (r *AvailabilityRepository) CreateWindow(ctx context.Context, window *models.AvailabilityWindow) error {
+	now := time.Now()
+	window.CreatedAt = now
+	window.UpdatedAt = now
+	return r.db.WithContext(ctx).Create(window).Error
+}
+
+func (r *AvailabilityRepository) UpdateWindow(ctx context.Context, window *models.AvailabilityWindow) error {
+	window.UpdatedAt = time.Now()
+	return r.db.WithContext(ctx).
+		Model(&models.AvailabilityWindow{}).
+		Where("id = ?", window.ID).
+		Updates(map[string]interface{}{
+			"weekday":    window.Weekday,
+			"start_time": window.StartTime,
+			"end_time":   window.EndTime,
+			"updated_at": window.UpdatedAt,
+		}).Error
+}
+
+func (r *AvailabilityRepository) DeleteWindow(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Delete(&models.AvailabilityWindow{}, id).Error
+}
+
+func (r *AvailabilityRepository) ListBlackouts(ctx context.Context, volunteerID int64) ([]*models.AvailabilityBlackout, error) {
+	var blackouts []*models.AvailabilityBlackout
+	result := r.db.WithContext(ctx).
+		Where("volunteer_id = ?", volunteerID).
+		Order("start_date").
+		Find(&blackouts)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return blackouts, nil
+}
+
+func (r *AvailabilityRepository) GetBlackout(ctx context.Context, id int64) (*models.AvailabilityBlackout, error) {
+	var blackout models.AvailabilityBlackout
+	result := r.db.WithContext(ctx).First(&blackout, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &blackout, nil
+}
+
+func (r *AvailabilityRepository) CreateBlackout(ctx context.Context, blackout *models.AvailabilityBlackout) error {
+	now := time.Now()
+	blackout.CreatedAt = now
+	blackout.UpdatedAt = now
+	return r.db.WithContext(ctx).Create(blackout).Error
+}
+
+func (r *AvailabilityRepository) UpdateBlackout(ctx context.Context, blackout *models.AvailabilityBlackout) error {
+	blackout.UpdatedAt = time.Now()
+	return r.db.WithContext(ctx).
+		Model(&models.AvailabilityBlackout{}).
+		Where("id = ?", blackout.ID).
+		Updates(map[string]interface{}{
+			"start_date": blackout.StartDate,
+			"end_date":   blackout.EndDate,
+			"reason":     blackout.Reason,
+			"updated_at": blackout.UpdatedAt,
+		}).Error
+}
+
+func (r *AvailabilityRepository) DeleteBlackout(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Delete(&models.AvailabilityBlackout{}, id).Error
+}
+
+// ListAvailableVolunteers returns the volunteers whose weekly windows cover
+// the whole shift in their own time zone and who are not away that day.
+// Volunteers without any windows are never returned.
+func (r *AvailabilityRepository) ListAvailableVolunteers(ctx context.Context, params *repository.AvailabilityParams) ([]*models.Volunteer, error) {
+	query := r.db.WithContext(ctx).
+		Table("volunteers v").
+		Select("v.*").
+		Joins(shiftLocalTimes, params.Start, params.End).
+		Where(shiftCovered).
+		Where(shiftNotBlackedOut)
+
+	if len(params.Skills) > 0 {
+		query = query.Where("v.skills && ?", pq.Array(params.Skills))
+	}
+	if params.Location != "" {
+		query = query.Where("LOWER(v.location) LIKE LOWER(?)", "%"+params.Location+"%")
+	}
+	if params.ExcludeEventID != 0 {
+		query = query.Where(`NOT EXISTS (
+			SELECT 1 FROM event_registrations er
+			WHERE er.event_id = ? AND er.volunteer_id = v.id AND er.status IN ?
+		)`, params.ExcludeEventID, []string{models.RegistrationStatusPending, models.RegistrationStatusRegistered})
+	}
+
+	var volunteers []*models.Volunteer
+	result := query.
+		Offset(params.Offset).
+		Limit(params.Limit).
+		Order("v.id").
+		Find(&volunteers)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return volunteers, nil
+}
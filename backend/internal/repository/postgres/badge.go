@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"time"
+	"volunteer-management/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// attendedEvents joins a volunteer's attended registrations to their events
+// and the organization credited with each.
+const attendedEvents = `FROM event_registrations er
+	JOIN events e ON e.id = er.event_id
+	JOIN organizations o ON o.id = COALESCE(er.host_organization_id, e.organization_id)
+	WHERE er.volunteer_id = ? AND er.status = '` + models.RegistrationStatusAttended + `'`
+
+type BadgeRepository struct {
+	db *gorm.DB
+}
+
+func NewBadgeRepository(db *gorm.DB) *BadgeRepository {
+	return &BadgeRepository{db: db}
+}
+
+func (r *BadgeRepository) Create(ctx context.Context, badge *models.Badge) error {
+	now := time.Now()
+	badge.CreatedAt = now
+	badge.UpdatedAt = now
+	return r.db.WithContext(ctx).Create(badge).Error
+}
+
+func (r *BadgeRepository) GetByID(ctx context.Context, id int64) (*models.Badge, error) {
+	var badge models.Badge
+	result := r.db.WithContext(ctx).First(&badge, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &badge, nil
+}
+
+func (r *BadgeRepository) Update(ctx context.Context, badge *models.Badge) error {
+	badge.UpdatedAt = time.Now()
+	return r.db.WithContext(ctx).
+		Model(&models.Badge{}).
+		Where("id = ?", badge.ID).
+		Updates(map[string]interface{}{
+			"name":            badge.Name,
+			"description":     badge.Description,
+			"icon":            badge.Icon,
+			"rule_type":       badge.RuleType,
+			"threshold":       badge.Threshold,
+			"category":        badge.Category,
+			"organization_id": badge.OrganizationID,
+			"active":          badge.Active,
+			"updated_at":      badge.UpdatedAt,
+		}).Error
+}
+
+// Delete removes a badge; achievements already awarded are kept.
+func (r *BadgeRepository) Delete(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Delete(&models.Badge{}, id).Error
+}
+
+func (r *BadgeRepository) List(ctx context.Context, activeOnly bool) ([]*models.Badge, error) {
+	var badges []*models.Badge
+	query := r.db.WithContext(ctx).Order("id")
+	if activeOnly {
+		query = query.Where("active")
+	}
+	if err := query.Find(&badges).Error; err != nil {
+		return nil, err
+	}
+	return badges, nil
+}
+
+// GetStats collects what badge rules look at for one volunteer.
+func (r *BadgeRepository) GetStats(ctx context.Context, volunteerID int64) (*models.BadgeStats, error) {
+	db := r.db.WithContext(ctx)
+	stats := &models.BadgeStats{EventsByCategory: make(map[string]int)}
+
+	if err := db.Raw(`SELECT COALESCE(total_hours, 0) FROM volunteers WHERE id = ?`, volunteerID).
+		Scan(&stats.TotalHours).Error; err != nil {
+		return nil, err
+	}
+
+	var categories []struct {
+		Category string
+		Events   int
+	}
+	if err := db.Raw(`SELECT LOWER(e.category) AS category, COUNT(*) AS events `+attendedEvents+`
+		AND e.category IS NOT NULL GROUP BY LOWER(e.category)`, volunteerID).
+		Scan(&categories).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range categories {
+		stats.EventsByCategory[row.Category] = row.Events
+	}
+
+	var months []struct {
+		Month time.Time
+	}
+	if err := db.Raw(`SELECT DISTINCT date_trunc('month', COALESCE(e.start_time, e.date)) AS month `+attendedEvents+`
+		ORDER BY month`, volunteerID).
+		Scan(&months).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range months {
+		stats.ActiveMonths = append(stats.ActiveMonths, row.Month)
+	}
+
+	if err := db.Raw(`SELECT o.id, o.name, MIN(COALESCE(e.start_time, e.date)) AS first_attended `+attendedEvents+`
+		GROUP BY o.id, o.name ORDER BY first_attended`, volunteerID).
+		Scan(&stats.Organizations).Error; err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// Award stores the achievement unless the volunteer already has it, and
+// reports whether it was new.
+func (r *BadgeRepository) Award(ctx context.Context, achievement *models.Achievement) (bool, error) {
+	achievement.CreatedAt = time.Now()
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(achievement)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
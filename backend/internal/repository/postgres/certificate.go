@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"volunteer-management/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// approvedHours selects a volunteer's approved hour submissions with the
+// event, the organization credited and the coordinator who approved them.
+// Adjusted hours under dispute keep counting, as they do in the volunteer's
+// totals.
+const approvedHours = `SELECT e.id AS event_id, e.title AS event_title, o.name AS organization_name,
+		COALESCE(e.start_time, e.date) AS date, hs.approved_hours AS hours,
+		r.name AS approved_by, hs.reviewed_at AS approved_at
+	FROM hour_submissions hs
+	JOIN events e ON e.id = hs.event_id
+	JOIN organizations o ON o.id = hs.organization_id
+	LEFT JOIN users r ON r.id = hs.reviewed_by
+	WHERE hs.volunteer_id = ?
+	AND hs.approved_hours IS NOT NULL
+	AND hs.status IN ('` + string(models.HoursStatusApproved) + `', '` + string(models.HoursStatusAdjusted) + `', '` + string(models.HoursStatusDisputed) + `')`
+
+type CertificateRepository struct {
+	db *gorm.DB
+}
+
+func NewCertificateRepository(db *gorm.DB) *CertificateRepository {
+	return &CertificateRepository{db: db}
+}
+
+// ListApprovedHours returns every event the volunteer has approved hours
+// for, oldest first.
+func (r *CertificateRepository) ListApprovedHours(ctx context.Context, volunteerID int64) ([]*models.ServiceHoursEntry, error) {
+	var entries []*models.ServiceHoursEntry
+	if err := r.db.WithContext(ctx).Raw(approvedHours+` ORDER BY date, e.id`, volunteerID).
+		Scan(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (r *CertificateRepository) GetApprovedHours(ctx context.Context, eventID, volunteerID int64) (*models.ServiceHoursEntry, error) {
+	var entries []*models.ServiceHoursEntry
+	if err := r.db.WithContext(ctx).Raw(approvedHours+` AND hs.event_id = ?`, volunteerID, eventID).
+		Scan(&entries).Error; err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	return entries[0], nil
+}
+
+func (r *CertificateRepository) Create(ctx context.Context, document *models.IssuedDocument) error {
+	return r.db.WithContext(ctx).Create(document).Error
+}
+
+func (r *CertificateRepository) GetByCode(ctx context.Context, code string) (*models.IssuedDocument, error) {
+	var document models.IssuedDocument
+	result := r.db.WithContext(ctx).Where("code = ?", code).First(&document)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &document, nil
+}
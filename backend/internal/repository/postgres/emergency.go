@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"time"
+	"volunteer-management/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type EmergencyInfoRepository struct {
+	db *gorm.DB
+}
+
+func NewEmergencyInfoRepository(db *gorm.DB) *EmergencyInfoRepository {
+	return &EmergencyInfoRepository{db: db}
+}
+
+func (r *EmergencyInfoRepository) Get(ctx context.Context, volunteerID int64) (*models.EmergencyInfoRecord, error) {
+	var record models.EmergencyInfoRecord
+	result := r.db.WithContext(ctx).Where("volunteer_id = ?", volunteerID).First(&record)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &record, nil
+}
+
+// Save stores the volunteer's sealed record, replacing any earlier one.
+func (r *EmergencyInfoRepository) Save(ctx context.Context, record *models.EmergencyInfoRecord) error {
+	now := time.Now()
+	record.CreatedAt = now
+	record.UpdatedAt = now
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "volunteer_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"key_id", "wrapped_key", "ciphertext", "updated_at"}),
+		}).
+		Create(record).Error
+}
+
+func (r *EmergencyInfoRepository) Delete(ctx context.Context, volunteerID int64) error {
+	return r.db.WithContext(ctx).
+		Where("volunteer_id = ?", volunteerID).
+		Delete(&models.EmergencyInfoRecord{}).Error
+}
+
+func (r *EmergencyInfoRepository) ListByVolunteers(ctx context.Context, volunteerIDs []int64) ([]*models.EmergencyInfoRecord, error) {
+	var records []*models.EmergencyInfoRecord
+	if len(volunteerIDs) == 0 {
+		return records, nil
+	}
+	result := r.db.WithContext(ctx).Where("volunteer_id IN ?", volunteerIDs).Find(&records)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return records, nil
+}
+
+func (r *EmergencyInfoRepository) LogAccess(ctx context.Context, accesses []*models.EmergencyInfoAccess) error {
+	if len(accesses) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Create(&accesses).Error
+}
+
+// ListAccesses returns who read the volunteer's emergency info, most recent
+// first.
+func (r *EmergencyInfoRepository) ListAccesses(ctx context.Context, volunteerID int64, offset, limit int) ([]*models.EmergencyInfoAccess, error) {
+	var accesses []*models.EmergencyInfoAccess
+	result := r.db.WithContext(ctx).
+		Select("emergency_info_accesses.*, e.title AS event_title, o.name AS organization_name, u.name AS accessed_by_name").
+		Joins("JOIN events e ON e.id = emergency_info_accesses.event_id").
+		Joins("JOIN organizations o ON o.id = emergency_info_accesses.organization_id").
+		Joins("JOIN users u ON u.id = emergency_info_accesses.accessed_by").
+		Where("emergency_info_accesses.volunteer_id = ?", volunteerID).
+		Order("emergency_info_accesses.accessed_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&accesses)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return accesses, nil
+}
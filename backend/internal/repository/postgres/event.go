@@ -27,6 +27,7 @@ func (r *EventRepository) Create(ctx context.Context, input *models.CreateEventI
 		Date:                 input.Date,
 		StartTime:            input.StartTime,
 		EndTime:              input.EndTime,
+		TimeZone:             input.TimeZone,
 		OrganizationID:       organizationID,
 		Category:             input.Category,
 		Image:                input.Image,
@@ -34,10 +35,19 @@ func (r *EventRepository) Create(ctx context.Context, input *models.CreateEventI
 		VolunteersNeeded:     input.VolunteersNeeded,
 		VolunteersRegistered: 0,
 		Status:               input.Status,
+		SignupApproval:       input.SignupApproval,
+		MinVerificationLevel: input.MinVerificationLevel,
 		CreatedAt:            time.Now(),
 		UpdatedAt:            time.Now(),
 	}
 
+	if event.SignupApproval == "" {
+		event.SignupApproval = models.SignupApprovalAuto
+	}
+	if event.MinVerificationLevel == "" {
+		event.MinVerificationLevel = models.VerificationNone
+	}
+
 	result := r.db.WithContext(ctx).Create(event)
 	if result.Error != nil {
 		return nil, result.Error
@@ -67,6 +77,19 @@ func (r *EventRepository) Update(ctx context.Context, id int64, input *models.Up
 		return nil, nil
 	}
 
+	updates := eventUpdates(input)
+	updates["updated_at"] = time.Now()
+
+	result := r.db.WithContext(ctx).Model(event).Updates(updates)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return event, nil
+}
+
+// eventUpdates lists the columns an update sets.
+func eventUpdates(input *models.UpdateEventInput) map[string]interface{} {
 	updates := make(map[string]interface{})
 	if input.Title != nil {
 		updates["title"] = *input.Title
@@ -89,6 +112,9 @@ func (r *EventRepository) Update(ctx context.Context, id int64, input *models.Up
 	if input.EndTime != nil {
 		updates["end_time"] = *input.EndTime
 	}
+	if input.TimeZone != nil {
+		updates["time_zone"] = *input.TimeZone
+	}
 	if input.Category != nil {
 		updates["category"] = *input.Category
 	}
@@ -104,27 +130,125 @@ func (r *EventRepository) Update(ctx context.Context, id int64, input *models.Up
 	if input.Status != nil {
 		updates["status"] = *input.Status
 	}
-	if input.ImpactMetrics != nil {
-		updates["impact_metrics"] = *input.ImpactMetrics
+	if input.SignupApproval != nil {
+		updates["signup_approval"] = *input.SignupApproval
 	}
-	updates["updated_at"] = time.Now()
+	if input.MinVerificationLevel != nil {
+		updates["min_verification_level"] = *input.MinVerificationLevel
+	}
+	return updates
+}
 
-	result := r.db.WithContext(ctx).Model(event).Updates(updates)
+// Cancel marks the event as cancelled, records the organizer's message and
+// cancels its open registrations in the same transaction, along with any
+// other changes in input, which may be nil. It returns the registrations it
+// cancelled.
+func (r *EventRepository) Cancel(ctx context.Context, id int64, message string, input *models.UpdateEventInput) (*models.Event, []*models.EventRegistration, error) {
+	event, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if event == nil {
+		return nil, nil, nil
+	}
+
+	updates := make(map[string]interface{})
+	if input != nil {
+		updates = eventUpdates(input)
+	}
+	now := time.Now()
+	updates["status"] = models.EventStatusCancelled
+	updates["cancellation_message"] = message
+	updates["cancelled_at"] = now
+	updates["updated_at"] = now
+
+	var registrations []*models.EventRegistration
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(event).Updates(updates).Error; err != nil {
+			return err
+		}
+		registrations, err = cancelRegistrations(tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return event, registrations, nil
+}
+
+// Review records an admin's moderation outcome on the event. It returns nil
+// when the event is no longer awaiting review.
+func (r *EventRepository) Review(ctx context.Context, id int64, status models.EventStatus, note string, reviewerID int64) (*models.Event, error) {
+	event, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, nil
+	}
+
+	now := time.Now()
+	updates := map[string]interface{}{
+		"status":      status,
+		"review_note": note,
+		"reviewed_by": reviewerID,
+		"reviewed_at": now,
+		"updated_at":  now,
+	}
+
+	result := r.db.WithContext(ctx).Model(event).
+		Where("status = ?", models.EventStatusPendingReview).
+		Updates(updates)
 	if result.Error != nil {
 		return nil, result.Error
 	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
 
 	return event, nil
 }
 
+// ListPendingReview returns events awaiting moderation, oldest submission first.
+func (r *EventRepository) ListPendingReview(ctx context.Context, offset, limit int) ([]*models.Event, error) {
+	var events []*models.Event
+	result := r.db.WithContext(ctx).
+		Where("status = ?", models.EventStatusPendingReview).
+		Offset(offset).
+		Limit(limit).
+		Order("updated_at ASC").
+		Find(&events)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return events, nil
+}
+
+// SetWaiver attaches a waiver to the event, or detaches it when waiverID is nil.
+func (r *EventRepository) SetWaiver(ctx context.Context, id int64, waiverID *int64) error {
+	result := r.db.WithContext(ctx).Model(&models.Event{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"waiver_id":  waiverID,
+			"updated_at": time.Now(),
+		})
+
+	return result.Error
+}
+
 func (r *EventRepository) Delete(ctx context.Context, id int64) error {
 	result := r.db.WithContext(ctx).Delete(&models.Event{}, id)
 	return result.Error
 }
 
+// List returns events that are not awaiting or failing review.
 func (r *EventRepository) List(ctx context.Context, offset, limit int) ([]*models.Event, error) {
 	var events []*models.Event
 	result := r.db.WithContext(ctx).
+		Where("status NOT IN ?", models.EventReviewStatuses).
 		Offset(offset).
 		Limit(limit).
 		Order("date ASC").
@@ -137,10 +261,12 @@ func (r *EventRepository) List(ctx context.Context, offset, limit int) ([]*model
 	return events, nil
 }
 
+// ListByOrganization returns the events the organization hosts, including
+// events it co-hosts.
 func (r *EventRepository) ListByOrganization(ctx context.Context, organizationID int64, offset, limit int) ([]*models.Event, error) {
 	var events []*models.Event
 	result := r.db.WithContext(ctx).
-		Where("organization_id = ?", organizationID).
+		Where("organization_id = ? OR id IN (?)", organizationID, acceptedCohostEvents(r.db, organizationID)).
 		Offset(offset).
 		Limit(limit).
 		Order("date ASC").
@@ -170,11 +296,15 @@ func (r *EventRepository) ListByStatus(ctx context.Context, status models.EventS
 }
 
 func (r *EventRepository) Search(ctx context.Context, params repository.SearchParams) ([]*models.Event, error) {
-	query := r.db.Model(&models.Event{})
+	query := r.db.Model(&models.Event{}).
+		Where("status NOT IN ?", models.EventReviewStatuses)
 
-	// Add date filter if provided
-	if !params.Date.IsZero() {
-		query = query.Where("date = ?", params.Date)
+	// Date filters compare against the local calendar day of each event
+	if !params.DateFrom.IsZero() {
+		query = query.Where("(COALESCE(start_time, date) AT TIME ZONE time_zone)::date >= ?::date", params.DateFrom.Format("2006-01-02"))
+	}
+	if !params.DateTo.IsZero() {
+		query = query.Where("(COALESCE(start_time, date) AT TIME ZONE time_zone)::date <= ?::date", params.DateTo.Format("2006-01-02"))
 	}
 
 	// Add location filter if provided
@@ -262,3 +392,34 @@ func (r *EventRepository) ListByVolunteer(ctx context.Context, volunteerID int64
 
 	return events, nil
 }
+
+// ListCommitments lists the events the volunteer is registered for or
+// attended that overlap [from, to), ordered by start time.
+func (r *EventRepository) ListCommitments(ctx context.Context, volunteerID int64, from, to time.Time) ([]*models.ScheduleEntry, error) {
+	query := `
+		SELECT e.id AS event_id, e.title, e.location, e.start_time, e.end_time, e.time_zone, er.status
+		FROM events e
+		JOIN event_registrations er ON er.event_id = e.id
+		WHERE er.volunteer_id = ? AND er.status IN ?
+			AND e.status <> ? AND e.end_time > ? AND e.start_time < ?
+		ORDER BY e.start_time, e.id`
+
+	var entries []*models.ScheduleEntry
+	result := r.db.WithContext(ctx).Raw(query,
+		volunteerID,
+		[]string{models.RegistrationStatusRegistered, models.RegistrationStatusAttended},
+		models.EventStatusCancelled, from, to,
+	).Scan(&entries)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return entries, nil
+}
+
+// acceptedCohostEvents selects the IDs of events the organization co-hosts.
+func acceptedCohostEvents(db *gorm.DB, organizationID int64) *gorm.DB {
+	return db.Table("event_cohosts").
+		Select("event_id").
+		Where("organization_id = ? AND status = ?", organizationID, models.CohostStatusAccepted)
+}
@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"context"
+	"time"
+	"volunteer-management/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type EventCohostRepository struct {
+	db *gorm.DB
+}
+
+func NewEventCohostRepository(db *gorm.DB) *EventCohostRepository {
+	return &EventCohostRepository{db: db}
+}
+
+// Invite creates a pending invitation, or resets a previously declined one.
+func (r *EventCohostRepository) Invite(ctx context.Context, eventID, organizationID, invitedBy int64) (*models.EventCohost, error) {
+	cohost := &models.EventCohost{
+		EventID:        eventID,
+		OrganizationID: organizationID,
+		Status:         models.CohostStatusPending,
+		InvitedBy:      invitedBy,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "event_id"}, {Name: "organization_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"status":       models.CohostStatusPending,
+			"invited_by":   invitedBy,
+			"responded_at": nil,
+			"updated_at":   time.Now(),
+		}),
+	}).Create(cohost)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.Get(ctx, eventID, organizationID)
+}
+
+func (r *EventCohostRepository) Get(ctx context.Context, eventID, organizationID int64) (*models.EventCohost, error) {
+	var cohost models.EventCohost
+	result := r.db.WithContext(ctx).
+		Where("event_id = ? AND organization_id = ?", eventID, organizationID).
+		First(&cohost)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &cohost, nil
+}
+
+func (r *EventCohostRepository) ListByEvent(ctx context.Context, eventID int64) ([]*models.EventCohost, error) {
+	var cohosts []*models.EventCohost
+	result := r.db.WithContext(ctx).
+		Select("event_cohosts.*, organizations.name AS organization_name").
+		Joins("JOIN organizations ON organizations.id = event_cohosts.organization_id").
+		Where("event_cohosts.event_id = ?", eventID).
+		Order("event_cohosts.created_at").
+		Find(&cohosts)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return cohosts, nil
+}
+
+// ListPendingByOrganization returns the invitations an organization has not answered yet.
+func (r *EventCohostRepository) ListPendingByOrganization(ctx context.Context, organizationID int64) ([]*models.EventCohost, error) {
+	var cohosts []*models.EventCohost
+	result := r.db.WithContext(ctx).
+		Where("organization_id = ? AND status = ?", organizationID, models.CohostStatusPending).
+		Order("created_at DESC").
+		Find(&cohosts)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return cohosts, nil
+}
+
+func (r *EventCohostRepository) Respond(ctx context.Context, eventID, organizationID int64, status models.CohostStatus) (*models.EventCohost, error) {
+	now := time.Now()
+	result := r.db.WithContext(ctx).Model(&models.EventCohost{}).
+		Where("event_id = ? AND organization_id = ?", eventID, organizationID).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"responded_at": now,
+			"updated_at":   now,
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.Get(ctx, eventID, organizationID)
+}
+
+func (r *EventCohostRepository) Delete(ctx context.Context, eventID, organizationID int64) error {
+	return r.db.WithContext(ctx).
+		Where("event_id = ? AND organization_id = ?", eventID, organizationID).
+		Delete(&models.EventCohost{}).Error
+}
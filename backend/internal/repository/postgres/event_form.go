@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"time"
+	"volunteer-management/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type EventFormRepository struct {
+	db *gorm.DB
+}
+
+func NewEventFormRepository(db *gorm.DB) *EventFormRepository {
+	return &EventFormRepository{db: db}
+}
+
+// Create stores the questions as the next version of the event's form.
+func (r *EventFormRepository) Create(ctx context.Context, eventID int64, questions []models.FormQuestion, createdBy int64) (*models.EventForm, error) {
+	form := &models.EventForm{
+		EventID:   eventID,
+		Questions: questions,
+		CreatedBy: createdBy,
+		CreatedAt: time.Now(),
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current int
+		if err := tx.Model(&models.EventForm{}).
+			Where("event_id = ?", eventID).
+			Select("COALESCE(MAX(version), 0)").
+			Scan(&current).Error; err != nil {
+			return err
+		}
+
+		form.Version = current + 1
+		return tx.Create(form).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return form, nil
+}
+
+func (r *EventFormRepository) GetByID(ctx context.Context, id int64) (*models.EventForm, error) {
+	var form models.EventForm
+	result := r.db.WithContext(ctx).First(&form, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &form, nil
+}
+
+// GetCurrent returns the latest version of the event's form, or nil if the
+// event has no registration questions.
+func (r *EventFormRepository) GetCurrent(ctx context.Context, eventID int64) (*models.EventForm, error) {
+	var form models.EventForm
+	result := r.db.WithContext(ctx).
+		Where("event_id = ?", eventID).
+		Order("version DESC").
+		First(&form)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &form, nil
+}
+
+func (r *EventFormRepository) ListByEvent(ctx context.Context, eventID int64) ([]*models.EventForm, error) {
+	var forms []*models.EventForm
+	result := r.db.WithContext(ctx).
+		Where("event_id = ?", eventID).
+		Order("version DESC").
+		Find(&forms)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return forms, nil
+}
@@ -6,8 +6,19 @@ import (
 	"volunteer-management/internal/models"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// attendeesQuery lists the volunteers whose attendance can be recorded for an
+// event with the host they were recruited by.
+const attendeesQuery = `
+	SELECT er.volunteer_id, er.host_organization_id, u.name, u.email
+	FROM event_registrations er
+	JOIN volunteers v ON v.id = er.volunteer_id
+	JOIN users u ON u.id = v.user_id
+	WHERE er.event_id = ? AND er.status IN ?
+	ORDER BY er.volunteer_id`
+
 type EventRegistrationRepository struct {
 	db *gorm.DB
 }
@@ -16,24 +27,81 @@ func NewEventRegistrationRepository(db *gorm.DB) *EventRegistrationRepository {
 	return &EventRegistrationRepository{db: db}
 }
 
-func (r *EventRegistrationRepository) Create(ctx context.Context, input *models.CreateEventRegistrationInput) (*models.EventRegistration, error) {
+// Create registers the volunteer and records their waiver signature, if any,
+// in the same transaction. A registration that was declined or cancelled is
+// reopened rather than duplicated. Registrations that take a seat lock the
+// event so that concurrent signups cannot overbook it; Create returns nil
+// when the event has no seat left.
+func (r *EventRegistrationRepository) Create(ctx context.Context, input *models.CreateEventRegistrationInput, signature *models.WaiverSignature) (*models.EventRegistration, error) {
+	now := time.Now()
 	registration := &models.EventRegistration{
-		EventID:          input.EventID,
-		VolunteerID:      input.VolunteerID,
-		Status:           input.Status,
-		RegistrationDate: time.Now(),
-		CreatedAt:        time.Now(),
-		UpdatedAt:        time.Now(),
+		EventID:            input.EventID,
+		VolunteerID:        input.VolunteerID,
+		Status:             input.Status,
+		HostOrganizationID: input.HostOrganizationID,
+		FormID:             input.FormID,
+		Answers:            input.Answers,
+		RegistrationDate:   now,
+		CreatedAt:          now,
+		UpdatedAt:          now,
 	}
+	full := false
 
-	result := r.db.WithContext(ctx).Create(registration)
-	if result.Error != nil {
-		return nil, result.Error
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if registration.HoldsSeat() {
+			seatsLeft, err := lockSeatsLeft(tx, registration.EventID)
+			if err != nil {
+				return err
+			}
+			if seatsLeft == 0 {
+				full = true
+				return nil
+			}
+		}
+
+		if err := saveRegistration(tx, registration); err != nil {
+			return err
+		}
+
+		if signature != nil {
+			signature.CreatedAt = now
+			if err := tx.Create(signature).Error; err != nil {
+				return err
+			}
+		}
+		return refreshRegisteredCount(tx, registration.EventID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if full {
+		return nil, nil
 	}
 
 	return registration, nil
 }
 
+// saveRegistration inserts the registration, or reopens the volunteer's
+// declined or cancelled registration for the event in its place.
+func saveRegistration(tx *gorm.DB, registration *models.EventRegistration) error {
+	var previous models.EventRegistration
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("event_id = ? AND volunteer_id = ? AND status IN ?",
+			registration.EventID, registration.VolunteerID, models.RegistrationReopenStatuses).
+		Limit(1).
+		Find(&previous).Error; err != nil {
+		return err
+	}
+	if previous.ID == 0 {
+		return tx.Create(registration).Error
+	}
+
+	// Saving every column clears the previous decision and cancellation
+	registration.ID = previous.ID
+	registration.CreatedAt = previous.CreatedAt
+	return tx.Save(registration).Error
+}
+
 func (r *EventRegistrationRepository) GetByID(ctx context.Context, id int64) (*models.EventRegistration, error) {
 	var registration models.EventRegistration
 	result := r.db.WithContext(ctx).First(&registration, id)
@@ -73,31 +141,25 @@ func (r *EventRegistrationRepository) Update(ctx context.Context, id int64, inpu
 	updates := make(map[string]interface{})
 	if input.Status != nil {
 		updates["status"] = *input.Status
-	}
-	if input.HoursLogged != nil {
-		updates["hours_logged"] = *input.HoursLogged
-	}
-	if input.Feedback != nil {
-		updates["feedback"] = *input.Feedback
-	}
-	if input.Rating != nil {
-		updates["rating"] = *input.Rating
+		if *input.Status == models.RegistrationStatusCancelled && registration.Status != models.RegistrationStatusCancelled {
+			updates["cancelled_at"] = time.Now()
+		}
 	}
 	updates["updated_at"] = time.Now()
 
-	result := r.db.WithContext(ctx).Model(registration).Updates(updates)
-	if result.Error != nil {
-		return nil, result.Error
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(registration).Updates(updates).Error; err != nil {
+			return err
+		}
+		return refreshRegisteredCount(tx, registration.EventID)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return registration, nil
 }
 
-func (r *EventRegistrationRepository) Delete(ctx context.Context, id int64) error {
-	result := r.db.WithContext(ctx).Delete(&models.EventRegistration{}, id)
-	return result.Error
-}
-
 func (r *EventRegistrationRepository) ListByEvent(ctx context.Context, eventID int64, offset, limit int) ([]*models.EventRegistration, error) {
 	var registrations []*models.EventRegistration
 	result := r.db.WithContext(ctx).
@@ -130,10 +192,29 @@ func (r *EventRegistrationRepository) ListByVolunteer(ctx context.Context, volun
 	return registrations, nil
 }
 
+// ListByEventAndStatus lists an event's registrations in one status, oldest
+// first, so coordinators decide in the order volunteers applied.
+func (r *EventRegistrationRepository) ListByEventAndStatus(ctx context.Context, eventID int64, status string, offset, limit int) ([]*models.EventRegistration, error) {
+	var registrations []*models.EventRegistration
+	result := r.db.WithContext(ctx).
+		Where("event_id = ? AND status = ?", eventID, status).
+		Offset(offset).
+		Limit(limit).
+		Order("created_at").
+		Find(&registrations)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return registrations, nil
+}
+
+// CountByEvent counts the registrations holding one of the event's seats.
 func (r *EventRegistrationRepository) CountByEvent(ctx context.Context, eventID int64) (int, error) {
 	var count int64
 	result := r.db.WithContext(ctx).Model(&models.EventRegistration{}).
-		Where("event_id = ?", eventID).
+		Where("event_id = ? AND status IN ?", eventID, models.RegistrationSeatStatuses).
 		Count(&count)
 
 	if result.Error != nil {
@@ -142,3 +223,152 @@ func (r *EventRegistrationRepository) CountByEvent(ctx context.Context, eventID
 
 	return int(count), nil
 }
+
+// cancelRegistrations moves every pending or registered registration for
+// the event to the cancelled-by-organizer state and returns the registrations
+// it changed.
+func cancelRegistrations(tx *gorm.DB, eventID int64) ([]*models.EventRegistration, error) {
+	var registrations []*models.EventRegistration
+	if err := tx.
+		Where("event_id = ? AND status IN ?", eventID, []string{models.RegistrationStatusPending, models.RegistrationStatusRegistered}).
+		Find(&registrations).Error; err != nil {
+		return nil, err
+	}
+	if len(registrations) == 0 {
+		return registrations, nil
+	}
+
+	ids := make([]int64, len(registrations))
+	for i, registration := range registrations {
+		ids[i] = registration.ID
+		registration.Status = models.RegistrationStatusCancelledByOrganizer
+	}
+
+	if err := tx.Model(&models.EventRegistration{}).
+		Where("id IN ?", ids).
+		Updates(map[string]interface{}{
+			"status":     models.RegistrationStatusCancelledByOrganizer,
+			"updated_at": time.Now(),
+		}).Error; err != nil {
+		return nil, err
+	}
+	if err := refreshRegisteredCount(tx, eventID); err != nil {
+		return nil, err
+	}
+
+	return registrations, nil
+}
+
+// Decide records a coordinator's decision on a pending registration.
+// Approvals lock the event so that concurrent approvals cannot overfill it.
+// Decide reports false when the registration is no longer pending or, for an
+// approval, when the event has no seat left.
+func (r *EventRegistrationRepository) Decide(ctx context.Context, registration *models.EventRegistration) (bool, error) {
+	decided := false
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if registration.Status == models.RegistrationStatusRegistered {
+			seatsLeft, err := lockSeatsLeft(tx, registration.EventID)
+			if err != nil {
+				return err
+			}
+			if seatsLeft == 0 {
+				return nil
+			}
+		}
+
+		result := tx.Model(&models.EventRegistration{}).
+			Where("id = ? AND status = ?", registration.ID, models.RegistrationStatusPending).
+			Updates(map[string]interface{}{
+				"status":           registration.Status,
+				"decision_message": registration.DecisionMessage,
+				"decided_by":       registration.DecidedBy,
+				"decided_at":       registration.DecidedAt,
+				"updated_at":       registration.UpdatedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		decided = result.RowsAffected > 0
+		if !decided {
+			return nil
+		}
+		return refreshRegisteredCount(tx, registration.EventID)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return decided, nil
+}
+
+func (r *EventRegistrationRepository) ListAttendees(ctx context.Context, eventID int64) ([]*models.Attendee, error) {
+	var attendees []*models.Attendee
+	result := r.db.WithContext(ctx).Raw(attendeesQuery, eventID, models.RegistrationSeatStatuses).Scan(&attendees)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return attendees, nil
+}
+
+// HasRegisteredWith reports whether the volunteer has registered, in any
+// status, for an event the organization hosts or co-hosts.
+func (r *EventRegistrationRepository) HasRegisteredWith(ctx context.Context, volunteerID, organizationID int64) (bool, error) {
+	var count int64
+	result := r.db.WithContext(ctx).
+		Table("event_registrations er").
+		Joins("JOIN events e ON e.id = er.event_id").
+		Where("er.volunteer_id = ?", volunteerID).
+		Where("e.organization_id = ? OR e.id IN (?)", organizationID, acceptedCohostEvents(r.db, organizationID)).
+		Count(&count)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return count > 0, nil
+}
+
+// RecordAttendance applies every update in a single transaction, so a bulk
+// update is either recorded in full or not at all.
+func (r *EventRegistrationRepository) RecordAttendance(ctx context.Context, eventID int64, updates []*models.AttendanceUpdate, source string, recordedBy int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, update := range updates {
+			if err := recordAttendance(tx, eventID, update.VolunteerID, update.HostOrganizationID,
+				update.Status, update.Hours, source, recordedBy); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// lockSeatsLeft locks the event row until the transaction ends, so that
+// concurrent registrations cannot overbook it, and returns how many of its
+// seats are still free.
+func lockSeatsLeft(tx *gorm.DB, eventID int64) (int, error) {
+	var event models.Event
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "volunteers_needed").
+		First(&event, eventID).Error; err != nil {
+		return 0, err
+	}
+
+	var taken int64
+	if err := tx.Model(&models.EventRegistration{}).
+		Where("event_id = ? AND status IN ?", eventID, models.RegistrationSeatStatuses).
+		Count(&taken).Error; err != nil {
+		return 0, err
+	}
+
+	if seatsLeft := event.VolunteersNeeded - int(taken); seatsLeft > 0 {
+		return seatsLeft, nil
+	}
+	return 0, nil
+}
+
+// refreshRegisteredCount keeps the event's volunteers_registered in step with
+// the registrations holding its seats.
+func refreshRegisteredCount(tx *gorm.DB, eventID int64) error {
+	return tx.Exec(`UPDATE events SET volunteers_registered = (
+			SELECT COUNT(*) FROM event_registrations WHERE event_id = ? AND status IN ?
+		) WHERE id = ?`, eventID, models.RegistrationSeatStatuses, eventID).Error
+}
@@ -0,0 +1,202 @@
+package postgres
+
+import (
+	"context"
+	"time"
+	"volunteer-management/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// feedItems merges everything that can appear in a volunteer's feed: events
+// published by followed organizations, announcements from followed
+// organizations and the platform, and the volunteer's own badges and level
+// ups.
+const feedItems = `SELECT 'event' AS kind, e.id, COALESCE(e.reviewed_at, e.created_at) AS occurred_at,
+		e.title, e.short_description AS body, e.organization_id, o.name AS organization_name, e.id AS event_id
+	FROM events e
+	JOIN organizations o ON o.id = e.organization_id
+	JOIN organization_follows f ON f.organization_id = e.organization_id AND f.volunteer_id = @volunteer
+	WHERE e.status = '` + string(models.EventStatusActive) + `'
+	UNION ALL
+	SELECT 'announcement', a.id, a.created_at, a.title, a.body, a.organization_id, o.name, NULL
+	FROM announcements a
+	LEFT JOIN organizations o ON o.id = a.organization_id
+	WHERE a.organization_id IS NULL
+	OR a.organization_id IN (SELECT organization_id FROM organization_follows WHERE volunteer_id = @volunteer)
+	UNION ALL
+	SELECT 'achievement', ac.id, ac.awarded_date, ac.name, ac.description, ac.organization_id, o.name, NULL
+	FROM achievements ac
+	LEFT JOIN organizations o ON o.id = ac.organization_id
+	WHERE ac.volunteer_id = @volunteer
+	UNION ALL
+	SELECT 'level_up', n.id, n.created_at, n.title, n.message, NULL, NULL, NULL
+	FROM notifications n
+	WHERE n.user_id = @user AND n.type = '` + string(models.NotificationLevelUp) + `'`
+
+type FeedRepository struct {
+	db *gorm.DB
+}
+
+func NewFeedRepository(db *gorm.DB) *FeedRepository {
+	return &FeedRepository{db: db}
+}
+
+// Follow starts following the organization, or updates whether the follower
+// shares their profile, and refreshes the organization's follower count.
+func (r *FeedRepository) Follow(ctx context.Context, follow *models.OrganizationFollow) error {
+	if follow.CreatedAt.IsZero() {
+		follow.CreatedAt = time.Now()
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "organization_id"}, {Name: "volunteer_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"share_profile"}),
+		}).Create(follow).Error; err != nil {
+			return err
+		}
+		return refreshFollowerCount(tx, follow.OrganizationID)
+	})
+}
+
+func (r *FeedRepository) Unfollow(ctx context.Context, organizationID, volunteerID int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("organization_id = ? AND volunteer_id = ?", organizationID, volunteerID).
+			Delete(&models.OrganizationFollow{}).Error; err != nil {
+			return err
+		}
+		return refreshFollowerCount(tx, organizationID)
+	})
+}
+
+func (r *FeedRepository) GetFollow(ctx context.Context, organizationID, volunteerID int64) (*models.OrganizationFollow, error) {
+	var follow models.OrganizationFollow
+	result := r.db.WithContext(ctx).
+		Where("organization_id = ? AND volunteer_id = ?", organizationID, volunteerID).
+		First(&follow)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &follow, nil
+}
+
+func (r *FeedRepository) ListFollowing(ctx context.Context, volunteerID int64) ([]*models.OrganizationFollow, error) {
+	var follows []*models.OrganizationFollow
+	result := r.db.WithContext(ctx).
+		Select("organization_follows.*, o.name AS organization_name").
+		Joins("JOIN organizations o ON o.id = organization_follows.organization_id").
+		Where("organization_follows.volunteer_id = ?", volunteerID).
+		Order("o.name").
+		Find(&follows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return follows, nil
+}
+
+// ListFollowers returns the followers who share their profile with the
+// organization, most recent first.
+func (r *FeedRepository) ListFollowers(ctx context.Context, organizationID int64, offset, limit int) ([]*models.OrganizationFollower, error) {
+	var followers []*models.OrganizationFollower
+	result := r.db.WithContext(ctx).
+		Table("organization_follows f").
+		Select("f.volunteer_id, u.name, f.created_at AS followed_at").
+		Joins("JOIN volunteers v ON v.id = f.volunteer_id").
+		Joins("JOIN users u ON u.id = v.user_id").
+		Where("f.organization_id = ? AND f.share_profile", organizationID).
+		Order("f.created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Scan(&followers)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return followers, nil
+}
+
+func (r *FeedRepository) CreateAnnouncement(ctx context.Context, announcement *models.Announcement) error {
+	now := time.Now()
+	announcement.CreatedAt = now
+	announcement.UpdatedAt = now
+	return r.db.WithContext(ctx).Create(announcement).Error
+}
+
+func (r *FeedRepository) GetAnnouncement(ctx context.Context, id int64) (*models.Announcement, error) {
+	var announcement models.Announcement
+	result := r.db.WithContext(ctx).First(&announcement, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &announcement, nil
+}
+
+func (r *FeedRepository) DeleteAnnouncement(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Delete(&models.Announcement{}, id).Error
+}
+
+// ListAnnouncements returns an organization's announcements, or the
+// platform's when organizationID is nil, newest first.
+func (r *FeedRepository) ListAnnouncements(ctx context.Context, organizationID *int64, offset, limit int) ([]*models.Announcement, error) {
+	var announcements []*models.Announcement
+	query := r.db.WithContext(ctx).
+		Select("announcements.*, o.name AS organization_name").
+		Joins("LEFT JOIN organizations o ON o.id = announcements.organization_id")
+	if organizationID != nil {
+		query = query.Where("announcements.organization_id = ?", *organizationID)
+	} else {
+		query = query.Where("announcements.organization_id IS NULL")
+	}
+	result := query.
+		Order("announcements.created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&announcements)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return announcements, nil
+}
+
+// ListFeed returns up to limit feed items that come after the cursor, newest
+// first.
+func (r *FeedRepository) ListFeed(ctx context.Context, volunteerID, userID int64, cursor *models.FeedCursor, limit int) ([]*models.FeedItem, error) {
+	params := map[string]interface{}{
+		"volunteer": volunteerID,
+		"user":      userID,
+		"limit":     limit,
+	}
+	where := ""
+	if cursor != nil {
+		// Compared as a plain timestamp, like the columns, so the session
+		// time zone cannot shift the cursor.
+		where = ` WHERE (occurred_at, kind, id) < (CAST(@at AS TIMESTAMP), @kind, @id)`
+		params["at"] = cursor.OccurredAt.Format("2006-01-02 15:04:05.999999")
+		params["kind"] = string(cursor.Kind)
+		params["id"] = cursor.ID
+	}
+
+	var items []*models.FeedItem
+	err := r.db.WithContext(ctx).Raw(`SELECT * FROM (`+feedItems+`) feed`+where+`
+		ORDER BY occurred_at DESC, kind DESC, id DESC
+		LIMIT @limit`, params).
+		Scan(&items).Error
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// refreshFollowerCount recomputes the organization's denormalized follower
+// count.
+func refreshFollowerCount(tx *gorm.DB, organizationID int64) error {
+	return tx.Exec(`UPDATE organizations SET
+			follower_count = (SELECT COUNT(*) FROM organization_follows WHERE organization_id = ?)
+		WHERE id = ?`, organizationID, organizationID).Error
+}
@@ -0,0 +1,324 @@
+package postgres
+
+import (
+	"context"
+	"time"
+	"volunteer-management/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// participantsQuery lists each volunteer registered for or attending an
+// event with the host they were recruited by.
+const participantsQuery = `
+	SELECT event_id, volunteer_id, host_organization_id
+	FROM (
+		SELECT event_id, volunteer_id, host_organization_id FROM event_registrations
+		WHERE status IN ('` + models.RegistrationStatusRegistered + `', '` + models.RegistrationStatusAttended + `')
+	) p`
+
+type FeedbackRepository struct {
+	db *gorm.DB
+}
+
+func NewFeedbackRepository(db *gorm.DB) *FeedbackRepository {
+	return &FeedbackRepository{db: db}
+}
+
+// Save creates or replaces the volunteer's feedback for the event and
+// refreshes the rating averages of the event and the rated organization. An
+// edited comment is new content, so earlier reports and moderation are reset.
+func (r *FeedbackRepository) Save(ctx context.Context, feedback *models.EventFeedback) (*models.EventFeedback, error) {
+	now := time.Now()
+	feedback.CreatedAt = now
+	feedback.UpdatedAt = now
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "event_id"}, {Name: "volunteer_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"organization_id", "event_rating", "organization_rating", "comment", "status",
+				"report_count", "moderation_note", "moderated_by", "moderated_at", "updated_at",
+			}),
+		}).Create(feedback).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Exec("DELETE FROM feedback_reports WHERE feedback_id = ?", feedback.ID).Error; err != nil {
+			return err
+		}
+
+		return refreshRatings(tx, feedback.EventID, feedback.OrganizationID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByEventAndVolunteer(ctx, feedback.EventID, feedback.VolunteerID)
+}
+
+func (r *FeedbackRepository) GetByID(ctx context.Context, id int64) (*models.EventFeedback, error) {
+	var feedback models.EventFeedback
+	result := r.db.WithContext(ctx).First(&feedback, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &feedback, nil
+}
+
+func (r *FeedbackRepository) GetByEventAndVolunteer(ctx context.Context, eventID, volunteerID int64) (*models.EventFeedback, error) {
+	var feedback models.EventFeedback
+	result := r.db.WithContext(ctx).
+		Where("event_id = ? AND volunteer_id = ?", eventID, volunteerID).
+		First(&feedback)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &feedback, nil
+}
+
+// ListByEvent lists an event's feedback, newest first. Moderators pass
+// visibleOnly false to also see flagged and hidden comments.
+func (r *FeedbackRepository) ListByEvent(ctx context.Context, eventID int64, visibleOnly bool, offset, limit int) ([]*models.EventFeedback, error) {
+	query := r.db.WithContext(ctx).Where("event_id = ?", eventID)
+	if visibleOnly {
+		query = query.Where("status = ?", models.FeedbackStatusVisible)
+	}
+
+	var feedback []*models.EventFeedback
+	result := query.
+		Offset(offset).
+		Limit(limit).
+		Order("created_at DESC").
+		Find(&feedback)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return feedback, nil
+}
+
+func (r *FeedbackRepository) ListByStatus(ctx context.Context, status models.FeedbackStatus, offset, limit int) ([]*models.EventFeedback, error) {
+	var feedback []*models.EventFeedback
+	result := r.db.WithContext(ctx).
+		Where("status = ?", status).
+		Offset(offset).
+		Limit(limit).
+		Order("report_count DESC, updated_at").
+		Find(&feedback)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return feedback, nil
+}
+
+// Report records a user's report of abusive feedback. Each user counts once;
+// feedback reaching the threshold is flagged for moderation unless a
+// moderator already approved it.
+func (r *FeedbackRepository) Report(ctx context.Context, id, userID int64, threshold int) (*models.EventFeedback, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec(`INSERT INTO feedback_reports (feedback_id, user_id, created_at)
+			VALUES (?, ?, ?) ON CONFLICT (feedback_id, user_id) DO NOTHING`, id, userID, time.Now())
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		return tx.Model(&models.EventFeedback{}).
+			Where("id = ?", id).
+			Updates(map[string]interface{}{
+				"report_count": gorm.Expr("report_count + 1"),
+				"status": gorm.Expr("CASE WHEN status = ? AND moderated_at IS NULL AND report_count + 1 >= ? THEN ? ELSE status END",
+					models.FeedbackStatusVisible, threshold, models.FeedbackStatusFlagged),
+			}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+func (r *FeedbackRepository) Moderate(ctx context.Context, id int64, status models.FeedbackStatus, note string, moderatorID int64) (*models.EventFeedback, error) {
+	feedback, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if feedback == nil {
+		return nil, nil
+	}
+
+	now := time.Now()
+	result := r.db.WithContext(ctx).Model(feedback).Updates(map[string]interface{}{
+		"status":          status,
+		"moderation_note": note,
+		"moderated_by":    moderatorID,
+		"moderated_at":    now,
+		"updated_at":      now,
+	})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return feedback, nil
+}
+
+func (r *FeedbackRepository) GetParticipant(ctx context.Context, eventID, volunteerID int64) (*models.EventParticipant, error) {
+	var participants []*models.EventParticipant
+	result := r.db.WithContext(ctx).
+		Raw(participantsQuery+" WHERE event_id = ? AND volunteer_id = ?", eventID, volunteerID).
+		Scan(&participants)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if len(participants) == 0 {
+		return nil, nil
+	}
+	return participants[0], nil
+}
+
+func (r *FeedbackRepository) ListParticipants(ctx context.Context, eventID int64) ([]*models.EventParticipant, error) {
+	var participants []*models.EventParticipant
+	result := r.db.WithContext(ctx).
+		Raw(participantsQuery+" WHERE event_id = ?", eventID).
+		Scan(&participants)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return participants, nil
+}
+
+// SaveVolunteerRating creates or replaces the organization's rating of a
+// volunteer for an event.
+func (r *FeedbackRepository) SaveVolunteerRating(ctx context.Context, rating *models.VolunteerRating) (*models.VolunteerRating, error) {
+	now := time.Now()
+	rating.CreatedAt = now
+	rating.UpdatedAt = now
+
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "event_id"}, {Name: "volunteer_id"}, {Name: "organization_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rating", "note", "rated_by", "updated_at"}),
+	}).Create(rating)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return rating, nil
+}
+
+func (r *FeedbackRepository) ListVolunteerRatings(ctx context.Context, volunteerID int64) ([]*models.VolunteerRating, error) {
+	var ratings []*models.VolunteerRating
+	result := r.db.WithContext(ctx).
+		Table("volunteer_ratings").
+		Select("volunteer_ratings.*, e.title AS event_title, o.name AS organization_name").
+		Joins("JOIN events e ON e.id = volunteer_ratings.event_id").
+		Joins("JOIN organizations o ON o.id = volunteer_ratings.organization_id").
+		Where("volunteer_ratings.volunteer_id = ?", volunteerID).
+		Order("volunteer_ratings.created_at DESC").
+		Find(&ratings)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return ratings, nil
+}
+
+// GetOrganizationReport summarizes the feedback attributed to the
+// organization across the completed events it hosted or co-hosted.
+func (r *FeedbackRepository) GetOrganizationReport(ctx context.Context, orgID int64, filter models.FeedbackReportFilter, commentLimit int) (*models.FeedbackReport, error) {
+	report := &models.FeedbackReport{
+		OrganizationID:     orgID,
+		RatingDistribution: map[int]int{1: 0, 2: 0, 3: 0, 4: 0, 5: 0},
+		Events:             []*models.EventFeedbackSummary{},
+		RecentComments:     []*models.EventFeedback{},
+	}
+
+	events := r.db.WithContext(ctx).Table("events e").
+		Where("e.status = ?", models.EventStatusComplete).
+		Where("e.organization_id = ? OR e.id IN (?)", orgID, acceptedCohostEvents(r.db, orgID))
+	if !filter.From.IsZero() {
+		events = events.Where("e.date >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		events = events.Where("e.date < ?", filter.To)
+	}
+
+	err := events.Session(&gorm.Session{}).
+		Select(`e.id AS event_id, e.title, e.date,
+			(SELECT COUNT(*) FROM (`+participantsQuery+`) p
+				WHERE p.event_id = e.id AND COALESCE(p.host_organization_id, e.organization_id) = ?) AS participants,
+			COUNT(f.id) AS response_count,
+			COALESCE(AVG(f.event_rating), 0) AS average_event_rating,
+			COALESCE(AVG(f.organization_rating), 0) AS average_organization_rating`, orgID).
+		Joins("LEFT JOIN event_feedbacks f ON f.event_id = e.id AND f.organization_id = ?", orgID).
+		Group("e.id, e.title, e.date, e.organization_id").
+		Order("e.date DESC").
+		Scan(&report.Events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var eventRatingTotal, organizationRatingTotal float64
+	for _, event := range report.Events {
+		if event.Participants > 0 {
+			event.ResponseRate = float64(event.ResponseCount) / float64(event.Participants)
+		}
+		report.ResponseCount += event.ResponseCount
+		eventRatingTotal += event.AverageEventRating * float64(event.ResponseCount)
+		organizationRatingTotal += event.AverageOrganizationRating * float64(event.ResponseCount)
+	}
+	if report.ResponseCount > 0 {
+		report.AverageEventRating = eventRatingTotal / float64(report.ResponseCount)
+		report.AverageOrganizationRating = organizationRatingTotal / float64(report.ResponseCount)
+	}
+
+	feedback := r.db.WithContext(ctx).Model(&models.EventFeedback{}).
+		Where("organization_id = ? AND event_id IN (?)", orgID, events.Session(&gorm.Session{}).Select("e.id"))
+
+	var distribution []struct {
+		Rating int
+		Count  int
+	}
+	if err := feedback.Session(&gorm.Session{}).
+		Select("organization_rating AS rating, COUNT(*) AS count").
+		Group("organization_rating").
+		Scan(&distribution).Error; err != nil {
+		return nil, err
+	}
+	for _, bucket := range distribution {
+		report.RatingDistribution[bucket.Rating] = bucket.Count
+	}
+
+	if err := feedback.Session(&gorm.Session{}).
+		Where("status = ? AND comment <> ''", models.FeedbackStatusVisible).
+		Order("created_at DESC").
+		Limit(commentLimit).
+		Find(&report.RecentComments).Error; err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// refreshRatings recomputes the denormalized rating averages of an event and
+// an organization from their feedback.
+func refreshRatings(tx *gorm.DB, eventID, orgID int64) error {
+	if err := tx.Exec(`UPDATE events SET
+			rating_average = COALESCE((SELECT AVG(event_rating) FROM event_feedbacks WHERE event_id = ?), 0),
+			rating_count = (SELECT COUNT(*) FROM event_feedbacks WHERE event_id = ?)
+		WHERE id = ?`, eventID, eventID, eventID).Error; err != nil {
+		return err
+	}
+
+	return tx.Exec(`UPDATE organizations SET
+			rating_average = COALESCE((SELECT AVG(organization_rating) FROM event_feedbacks WHERE organization_id = ?), 0),
+			rating_count = (SELECT COUNT(*) FROM event_feedbacks WHERE organization_id = ?)
+		WHERE id = ?`, orgID, orgID, orgID).Error
+}
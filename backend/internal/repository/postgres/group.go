@@ -0,0 +1,379 @@
+package postgres
+
+import (
+	"context"
+	"time"
+	"volunteer-management/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GroupRepository struct {
+	db *gorm.DB
+}
+
+func NewGroupRepository(db *gorm.DB) *GroupRepository {
+	return &GroupRepository{db: db}
+}
+
+// groupsWithMemberCount selects groups along with their number of members.
+func (r *GroupRepository) groupsWithMemberCount(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Table("volunteer_groups").
+		Select("volunteer_groups.*, (SELECT COUNT(*) FROM group_members gm WHERE gm.group_id = volunteer_groups.id) AS member_count")
+}
+
+// Create stores the group and makes its creator the first leader.
+func (r *GroupRepository) Create(ctx context.Context, input *models.CreateGroupInput, leaderID int64) (*models.VolunteerGroup, error) {
+	now := time.Now()
+	group := &models.VolunteerGroup{
+		Name:        input.Name,
+		Description: input.Description,
+		Kind:        input.Kind,
+		CreatedBy:   leaderID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(group).Error; err != nil {
+			return err
+		}
+		return tx.Create(&models.GroupMember{
+			GroupID:     group.ID,
+			VolunteerID: leaderID,
+			Role:        models.GroupRoleLeader,
+			JoinedAt:    now,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	group.MemberCount = 1
+	return group, nil
+}
+
+func (r *GroupRepository) GetByID(ctx context.Context, id int64) (*models.VolunteerGroup, error) {
+	var group models.VolunteerGroup
+	result := r.groupsWithMemberCount(ctx).Where("volunteer_groups.id = ?", id).Take(&group)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &group, nil
+}
+
+func (r *GroupRepository) Update(ctx context.Context, id int64, input *models.UpdateGroupInput) (*models.VolunteerGroup, error) {
+	updates := make(map[string]interface{})
+	if input.Name != nil {
+		updates["name"] = *input.Name
+	}
+	if input.Description != nil {
+		updates["description"] = *input.Description
+	}
+	if input.Kind != nil {
+		updates["kind"] = *input.Kind
+	}
+	updates["updated_at"] = time.Now()
+
+	result := r.db.WithContext(ctx).Model(&models.VolunteerGroup{}).Where("id = ?", id).Updates(updates)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+func (r *GroupRepository) Delete(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Delete(&models.VolunteerGroup{}, id).Error
+}
+
+func (r *GroupRepository) ListByVolunteer(ctx context.Context, volunteerID int64) ([]*models.VolunteerGroup, error) {
+	var groups []*models.VolunteerGroup
+	result := r.groupsWithMemberCount(ctx).
+		Where("volunteer_groups.id IN (?)", r.db.Table("group_members").Select("group_id").Where("volunteer_id = ?", volunteerID)).
+		Order("volunteer_groups.name").
+		Find(&groups)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return groups, nil
+}
+
+func (r *GroupRepository) ListMembers(ctx context.Context, groupID int64) ([]*models.GroupMember, error) {
+	var members []*models.GroupMember
+	result := r.db.WithContext(ctx).
+		Table("group_members").
+		Select("group_members.*, u.name").
+		Joins("JOIN volunteers v ON v.id = group_members.volunteer_id").
+		Joins("JOIN users u ON u.id = v.user_id").
+		Where("group_members.group_id = ?", groupID).
+		Order("group_members.role, u.name").
+		Find(&members)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return members, nil
+}
+
+func (r *GroupRepository) GetMember(ctx context.Context, groupID, volunteerID int64) (*models.GroupMember, error) {
+	var member models.GroupMember
+	result := r.db.WithContext(ctx).
+		Where("group_id = ? AND volunteer_id = ?", groupID, volunteerID).
+		First(&member)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &member, nil
+}
+
+func (r *GroupRepository) SetMemberRole(ctx context.Context, groupID, volunteerID int64, role models.GroupRole) error {
+	return r.db.WithContext(ctx).Model(&models.GroupMember{}).
+		Where("group_id = ? AND volunteer_id = ?", groupID, volunteerID).
+		Update("role", role).Error
+}
+
+func (r *GroupRepository) RemoveMember(ctx context.Context, groupID, volunteerID int64) error {
+	return r.db.WithContext(ctx).
+		Where("group_id = ? AND volunteer_id = ?", groupID, volunteerID).
+		Delete(&models.GroupMember{}).Error
+}
+
+func (r *GroupRepository) CountLeaders(ctx context.Context, groupID int64) (int, error) {
+	var count int64
+	result := r.db.WithContext(ctx).Model(&models.GroupMember{}).
+		Where("group_id = ? AND role = ?", groupID, models.GroupRoleLeader).
+		Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return int(count), nil
+}
+
+func (r *GroupRepository) CreateInvitations(ctx context.Context, invitations []*models.GroupInvitation) error {
+	return r.db.WithContext(ctx).Create(&invitations).Error
+}
+
+func (r *GroupRepository) GetInvitation(ctx context.Context, id int64) (*models.GroupInvitation, error) {
+	var invitation models.GroupInvitation
+	result := r.db.WithContext(ctx).First(&invitation, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &invitation, nil
+}
+
+func (r *GroupRepository) GetInvitationByTokenHash(ctx context.Context, tokenHash string) (*models.GroupInvitation, error) {
+	var invitation models.GroupInvitation
+	result := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&invitation)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &invitation, nil
+}
+
+func (r *GroupRepository) ListInvitations(ctx context.Context, groupID int64) ([]*models.GroupInvitation, error) {
+	var invitations []*models.GroupInvitation
+	result := r.db.WithContext(ctx).
+		Where("group_id = ?", groupID).
+		Order("created_at DESC").
+		Find(&invitations)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return invitations, nil
+}
+
+func (r *GroupRepository) RevokeInvitation(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Model(&models.GroupInvitation{}).
+		Where("id = ? AND status = ?", id, models.GroupInvitationPending).
+		Updates(map[string]interface{}{
+			"status":     models.GroupInvitationRevoked,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+// AcceptInvitation marks the invitation accepted and adds the volunteer to
+// the group as a member. Accepting while already a member keeps the role. It
+// reports false when the invitation was no longer pending.
+func (r *GroupRepository) AcceptInvitation(ctx context.Context, invitation *models.GroupInvitation, volunteerID int64) (bool, error) {
+	now := time.Now()
+	accepted := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.GroupInvitation{}).
+			Where("id = ? AND status = ?", invitation.ID, models.GroupInvitationPending).
+			Updates(map[string]interface{}{
+				"status":      models.GroupInvitationAccepted,
+				"accepted_by": volunteerID,
+				"updated_at":  now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			// Accepted or revoked concurrently
+			return nil
+		}
+		accepted = true
+
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.GroupMember{
+			GroupID:     invitation.GroupID,
+			VolunteerID: volunteerID,
+			Role:        models.GroupRoleMember,
+			JoinedAt:    now,
+		}).Error
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return accepted, nil
+}
+
+// CreateSignup signs the requested members up for the event in one
+// transaction, holding a lock on the event so concurrent signups cannot
+// overbook it. A member's declined or cancelled registration for the event is
+// reopened. Members already marked skipped are recorded as such. When seats
+// run out, an all-or-nothing signup stores nothing and reports the seats that
+// were left; a partial signup skips the members beyond them.
+func (r *GroupRepository) CreateSignup(ctx context.Context, groupSignup *models.GroupSignup, members []*models.GroupSignupMember, template *models.EventRegistration) (int, error) {
+	seatsLeft := 0
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if seatsLeft, err = lockSeatsLeft(tx, groupSignup.EventID); err != nil {
+			return err
+		}
+
+		eligible := 0
+		for _, member := range members {
+			if member.Status == models.GroupSignupMemberSignedUp {
+				eligible++
+			}
+		}
+		// Pending registrations do not hold a seat until approved
+		needsSeats := template.HoldsSeat()
+		if needsSeats && groupSignup.Mode == models.GroupSignupAllOrNothing && eligible > seatsLeft {
+			groupSignup.ID = 0
+			return nil
+		}
+
+		groupSignup.CreatedAt = time.Now()
+		if err := tx.Create(groupSignup).Error; err != nil {
+			return err
+		}
+
+		seats := seatsLeft
+		for _, member := range members {
+			member.GroupSignupID = groupSignup.ID
+			if member.Status != models.GroupSignupMemberSignedUp {
+				continue
+			}
+			if needsSeats && seats == 0 {
+				member.Status = models.GroupSignupMemberSkipped
+				member.Reason = "event is full"
+				continue
+			}
+
+			registration := *template
+			registration.VolunteerID = member.VolunteerID
+			registration.GroupSignupID = &groupSignup.ID
+			if err := saveRegistration(tx, &registration); err != nil {
+				return err
+			}
+			member.RegistrationID = &registration.ID
+			groupSignup.SignedUpCount++
+			if needsSeats {
+				seats--
+			}
+		}
+
+		if len(members) > 0 {
+			if err := tx.Create(&members).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Model(groupSignup).Update("signed_up_count", groupSignup.SignedUpCount).Error; err != nil {
+			return err
+		}
+		return refreshRegisteredCount(tx, groupSignup.EventID)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return seatsLeft, nil
+}
+
+func (r *GroupRepository) GetSignup(ctx context.Context, id int64) (*models.GroupSignup, error) {
+	var groupSignup models.GroupSignup
+	result := r.db.WithContext(ctx).First(&groupSignup, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+
+	if err := r.db.WithContext(ctx).
+		Where("group_signup_id = ?", id).
+		Order("id").
+		Find(&groupSignup.Members).Error; err != nil {
+		return nil, err
+	}
+	return &groupSignup, nil
+}
+
+func (r *GroupRepository) ListSignups(ctx context.Context, groupID int64) ([]*models.GroupSignup, error) {
+	var groupSignups []*models.GroupSignup
+	result := r.db.WithContext(ctx).
+		Where("group_id = ?", groupID).
+		Order("created_at DESC").
+		Find(&groupSignups)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return groupSignups, nil
+}
+
+// RecordAttendance stores each member's attendance on the group signup and
+// records it on their event registration and hours like any attendance
+// entered by the host.
+func (r *GroupRepository) RecordAttendance(ctx context.Context, groupSignup *models.GroupSignup, entries []models.GroupAttendanceEntry, recordedBy int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, entry := range entries {
+			hours := 0.0
+			if entry.HoursLogged != nil {
+				hours = *entry.HoursLogged
+			}
+
+			if err := tx.Model(&models.GroupSignupMember{}).
+				Where("group_signup_id = ? AND volunteer_id = ?", groupSignup.ID, entry.VolunteerID).
+				Updates(map[string]interface{}{
+					"attendance":   entry.Status,
+					"hours_logged": hours,
+				}).Error; err != nil {
+				return err
+			}
+
+			if err := recordAttendance(tx, groupSignup.EventID, entry.VolunteerID, groupSignup.HostOrganizationID,
+				entry.Status, hours, "group attendance", recordedBy); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
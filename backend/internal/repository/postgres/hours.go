@@ -0,0 +1,277 @@
+package postgres
+
+import (
+	"context"
+	"time"
+	"volunteer-management/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// hourSubmissionColumns selects a submission with its event title and the
+// volunteer's name.
+const hourSubmissionColumns = "hour_submissions.*, e.title AS event_title, u.name AS volunteer_name"
+
+type HoursRepository struct {
+	db *gorm.DB
+}
+
+func NewHoursRepository(db *gorm.DB) *HoursRepository {
+	return &HoursRepository{db: db}
+}
+
+// Create stores a new submission together with the first entry of its
+// history.
+func (r *HoursRepository) Create(ctx context.Context, submission *models.HourSubmission, change *models.HoursChange) error {
+	now := time.Now()
+	submission.CreatedAt = now
+	submission.UpdatedAt = now
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(submission).Error; err != nil {
+			return err
+		}
+		return addHoursChange(tx, submission.ID, change)
+	})
+}
+
+// Update saves a changed submission and appends the change to its history.
+// Decisions are applied to the volunteer's registration and total hours in
+// the same transaction, so totals only ever reflect approved hours.
+func (r *HoursRepository) Update(ctx context.Context, submission *models.HourSubmission, change *models.HoursChange) error {
+	submission.UpdatedAt = time.Now()
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.HourSubmission{}).
+			Where("id = ?", submission.ID).
+			Updates(map[string]interface{}{
+				"hours":          submission.Hours,
+				"approved_hours": submission.ApprovedHours,
+				"status":         submission.Status,
+				"note":           submission.Note,
+				"review_reason":  submission.ReviewReason,
+				"reviewed_by":    submission.ReviewedBy,
+				"reviewed_at":    submission.ReviewedAt,
+				"dispute_reason": submission.DisputeReason,
+				"updated_at":     submission.UpdatedAt,
+			}).Error; err != nil {
+			return err
+		}
+
+		if err := addHoursChange(tx, submission.ID, change); err != nil {
+			return err
+		}
+
+		switch submission.Status {
+		case models.HoursStatusApproved, models.HoursStatusAdjusted:
+			if err := creditApprovedHours(tx, submission); err != nil {
+				return err
+			}
+			if err := refreshRegisteredCount(tx, submission.EventID); err != nil {
+				return err
+			}
+		case models.HoursStatusRejected:
+			if err := tx.Exec(`UPDATE event_registrations SET hours_logged = 0, updated_at = ?
+				WHERE event_id = ? AND volunteer_id = ?`,
+				submission.UpdatedAt, submission.EventID, submission.VolunteerID).Error; err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+
+		return refreshVolunteerHours(tx, submission.VolunteerID)
+	})
+}
+
+// GetByID returns a submission with its full history, oldest first.
+func (r *HoursRepository) GetByID(ctx context.Context, id int64) (*models.HourSubmission, error) {
+	var submission models.HourSubmission
+	result := r.withNames(ctx).Where("hour_submissions.id = ?", id).First(&submission)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+
+	if err := r.db.WithContext(ctx).
+		Where("submission_id = ?", id).
+		Order("created_at, id").
+		Find(&submission.History).Error; err != nil {
+		return nil, err
+	}
+	return &submission, nil
+}
+
+func (r *HoursRepository) GetByEventAndVolunteer(ctx context.Context, eventID, volunteerID int64) (*models.HourSubmission, error) {
+	var submission models.HourSubmission
+	result := r.withNames(ctx).
+		Where("hour_submissions.event_id = ? AND hour_submissions.volunteer_id = ?", eventID, volunteerID).
+		First(&submission)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &submission, nil
+}
+
+func (r *HoursRepository) ListByVolunteer(ctx context.Context, volunteerID int64, offset, limit int) ([]*models.HourSubmission, error) {
+	var submissions []*models.HourSubmission
+	result := r.withNames(ctx).
+		Where("hour_submissions.volunteer_id = ?", volunteerID).
+		Offset(offset).
+		Limit(limit).
+		Order("hour_submissions.created_at DESC").
+		Find(&submissions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return submissions, nil
+}
+
+// ListByEvent lists the submissions credited to an organization for an event.
+func (r *HoursRepository) ListByEvent(ctx context.Context, eventID, organizationID int64, offset, limit int) ([]*models.HourSubmission, error) {
+	var submissions []*models.HourSubmission
+	result := r.withNames(ctx).
+		Where("hour_submissions.event_id = ? AND hour_submissions.organization_id = ?", eventID, organizationID).
+		Offset(offset).
+		Limit(limit).
+		Order("hour_submissions.created_at").
+		Find(&submissions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return submissions, nil
+}
+
+// ListByOrganizationAndStatus returns an organization's review queue, oldest
+// first.
+func (r *HoursRepository) ListByOrganizationAndStatus(ctx context.Context, organizationID int64, statuses []models.HoursStatus, offset, limit int) ([]*models.HourSubmission, error) {
+	var submissions []*models.HourSubmission
+	result := r.withNames(ctx).
+		Where("hour_submissions.organization_id = ? AND hour_submissions.status IN ?", organizationID, statuses).
+		Offset(offset).
+		Limit(limit).
+		Order("hour_submissions.updated_at").
+		Find(&submissions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return submissions, nil
+}
+
+func (r *HoursRepository) withNames(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Model(&models.HourSubmission{}).
+		Select(hourSubmissionColumns).
+		Joins("JOIN events e ON e.id = hour_submissions.event_id").
+		Joins("JOIN volunteers v ON v.id = hour_submissions.volunteer_id").
+		Joins("JOIN users u ON u.id = v.user_id")
+}
+
+func addHoursChange(tx *gorm.DB, submissionID int64, change *models.HoursChange) error {
+	change.SubmissionID = submissionID
+	change.CreatedAt = time.Now()
+	return tx.Create(change).Error
+}
+
+// creditApprovedHours writes the approved hours onto the volunteer's event
+// registration, creating it when it is missing, and marks them attended.
+func creditApprovedHours(tx *gorm.DB, submission *models.HourSubmission) error {
+	hours := 0.0
+	if submission.ApprovedHours != nil {
+		hours = *submission.ApprovedHours
+	}
+
+	return tx.Exec(`INSERT INTO event_registrations
+			(event_id, volunteer_id, status, host_organization_id, hours_logged, registration_date, created_at, updated_at)
+		SELECT e.id, ?, ?, NULLIF(?, e.organization_id), ?, ?, ?, ?
+		FROM events e WHERE e.id = ?
+		ON CONFLICT (event_id, volunteer_id) DO UPDATE
+		SET status = EXCLUDED.status, hours_logged = EXCLUDED.hours_logged, updated_at = EXCLUDED.updated_at`,
+		submission.VolunteerID, models.RegistrationStatusAttended, submission.OrganizationID, hours,
+		submission.UpdatedAt, submission.UpdatedAt, submission.UpdatedAt, submission.EventID).Error
+}
+
+// recordAttendance marks a volunteer attended or no-show on their event
+// registration, creating it when it is missing, and records the
+// hours as decided by the host. Source names where the attendance was taken
+// for the hours history.
+func recordAttendance(tx *gorm.DB, eventID, volunteerID int64, hostOrganizationID *int64, status string, hours float64, source string, actorID int64) error {
+	now := time.Now()
+	approved, hoursStatus, reason := &hours, models.HoursStatusApproved, "recorded with "+source
+	if status != models.RegistrationStatusAttended {
+		hours = 0
+		approved, hoursStatus, reason = nil, models.HoursStatusRejected, "marked "+status+" with "+source
+	}
+
+	registration := &models.EventRegistration{
+		EventID:            eventID,
+		VolunteerID:        volunteerID,
+		Status:             status,
+		HostOrganizationID: hostOrganizationID,
+		HoursLogged:        hours,
+		RegistrationDate:   now,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+	if err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "event_id"}, {Name: "volunteer_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"status", "hours_logged", "updated_at"}),
+	}).Create(registration).Error; err != nil {
+		return err
+	}
+
+	if err := recordHostHours(tx, eventID, volunteerID, hostOrganizationID, approved, hoursStatus, reason, actorID); err != nil {
+		return err
+	}
+	if err := refreshRegisteredCount(tx, eventID); err != nil {
+		return err
+	}
+	return refreshVolunteerHours(tx, volunteerID)
+}
+
+// recordHostHours stores hours entered directly by an event host as an
+// already decided submission, keeping any hours the volunteer claimed.
+// Hosts recording a no-show pass a rejected status and no hours.
+func recordHostHours(tx *gorm.DB, eventID, volunteerID int64, hostOrganizationID *int64, hours *float64, status models.HoursStatus, reason string, actorID int64) error {
+	now := time.Now()
+	claimed := 0.0
+	if hours != nil {
+		claimed = *hours
+	}
+
+	var submissionID int64
+	if err := tx.Raw(`INSERT INTO hour_submissions
+			(event_id, volunteer_id, organization_id, hours, approved_hours, status, review_reason, reviewed_by, reviewed_at, created_at, updated_at)
+		SELECT e.id, ?, COALESCE(?, e.organization_id), ?, ?, ?, ?, ?, ?, ?, ?
+		FROM events e WHERE e.id = ?
+		ON CONFLICT (event_id, volunteer_id) DO UPDATE
+		SET approved_hours = EXCLUDED.approved_hours, status = EXCLUDED.status, review_reason = EXCLUDED.review_reason,
+			reviewed_by = EXCLUDED.reviewed_by, reviewed_at = EXCLUDED.reviewed_at, updated_at = EXCLUDED.updated_at
+		RETURNING id`,
+		volunteerID, hostOrganizationID, claimed, hours, status, reason, actorID, now, now, now, eventID).
+		Scan(&submissionID).Error; err != nil {
+		return err
+	}
+
+	return addHoursChange(tx, submissionID, &models.HoursChange{
+		Action:  models.HoursActionRecorded,
+		Hours:   hours,
+		Comment: reason,
+		ActorID: actorID,
+	})
+}
+
+// refreshVolunteerHours recomputes the volunteer's total from the approved
+// hours on their registrations.
+func refreshVolunteerHours(tx *gorm.DB, volunteerID int64) error {
+	return tx.Exec(`UPDATE volunteers SET total_hours = (
+			SELECT COALESCE(SUM(hours_logged), 0) FROM event_registrations WHERE volunteer_id = ?
+		), updated_at = ?
+		WHERE id = ?`, volunteerID, time.Now(), volunteerID).Error
+}
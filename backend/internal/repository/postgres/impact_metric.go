@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"context"
+	"time"
+	"volunteer-management/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ImpactMetricRepository struct {
+	db *gorm.DB
+}
+
+func NewImpactMetricRepository(db *gorm.DB) *ImpactMetricRepository {
+	return &ImpactMetricRepository{db: db}
+}
+
+func (r *ImpactMetricRepository) Create(ctx context.Context, input *models.CreateImpactMetricInput) (*models.ImpactMetricDefinition, error) {
+	categories := input.Categories
+	if categories == nil {
+		categories = []string{}
+	}
+
+	metric := &models.ImpactMetricDefinition{
+		Key:         input.Key,
+		Name:        input.Name,
+		Description: input.Description,
+		Unit:        input.Unit,
+		Aggregation: input.Aggregation,
+		Categories:  categories,
+		IsActive:    true,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	result := r.db.WithContext(ctx).Create(metric)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return metric, nil
+}
+
+func (r *ImpactMetricRepository) GetByID(ctx context.Context, id int64) (*models.ImpactMetricDefinition, error) {
+	var metric models.ImpactMetricDefinition
+	result := r.db.WithContext(ctx).First(&metric, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &metric, nil
+}
+
+func (r *ImpactMetricRepository) GetByKey(ctx context.Context, key string) (*models.ImpactMetricDefinition, error) {
+	var metric models.ImpactMetricDefinition
+	result := r.db.WithContext(ctx).Where("key = ?", key).First(&metric)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &metric, nil
+}
+
+func (r *ImpactMetricRepository) Update(ctx context.Context, id int64, input *models.UpdateImpactMetricInput) (*models.ImpactMetricDefinition, error) {
+	metric, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if metric == nil {
+		return nil, nil
+	}
+
+	if input.Name != nil {
+		metric.Name = *input.Name
+	}
+	if input.Description != nil {
+		metric.Description = *input.Description
+	}
+	if input.Unit != nil {
+		metric.Unit = *input.Unit
+	}
+	if input.Aggregation != nil {
+		metric.Aggregation = *input.Aggregation
+	}
+	if input.Categories != nil {
+		metric.Categories = *input.Categories
+	}
+	if input.IsActive != nil {
+		metric.IsActive = *input.IsActive
+	}
+	metric.UpdatedAt = time.Now()
+
+	result := r.db.WithContext(ctx).Save(metric)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return metric, nil
+}
+
+func (r *ImpactMetricRepository) List(ctx context.Context, activeOnly bool) ([]*models.ImpactMetricDefinition, error) {
+	var metrics []*models.ImpactMetricDefinition
+	query := r.db.WithContext(ctx).Order("name")
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+
+	if err := query.Find(&metrics).Error; err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+func (r *ImpactMetricRepository) ListEventValues(ctx context.Context, eventID int64) ([]*models.EventMetricValue, error) {
+	var values []*models.EventMetricValue
+	result := r.db.WithContext(ctx).
+		Select("event_metric_values.*, d.key AS metric_key, d.name AS metric_name, d.unit").
+		Joins("JOIN impact_metric_definitions d ON d.id = event_metric_values.metric_id").
+		Where("event_metric_values.event_id = ?", eventID).
+		Order("d.name").
+		Find(&values)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return values, nil
+}
+
+// SetEventValues upserts the given values and removes the metrics listed in
+// remove, all in one transaction.
+func (r *ImpactMetricRepository) SetEventValues(ctx context.Context, eventID int64, values map[int64]float64, remove []int64, recordedBy int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for metricID, value := range values {
+			row := &models.EventMetricValue{
+				EventID:    eventID,
+				MetricID:   metricID,
+				Value:      value,
+				RecordedBy: recordedBy,
+				CreatedAt:  time.Now(),
+				UpdatedAt:  time.Now(),
+			}
+			err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "event_id"}, {Name: "metric_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{"value", "recorded_by", "updated_at"}),
+			}).Create(row).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(remove) > 0 {
+			return tx.Where("event_id = ? AND metric_id IN ?", eventID, remove).
+				Delete(&models.EventMetricValue{}).Error
+		}
+		return nil
+	})
+}
+
+// Aggregate computes every aggregate of each metric over the active and
+// completed events matching the filter. With an interval the results are also
+// grouped by period of the event's local date.
+func (r *ImpactMetricRepository) Aggregate(ctx context.Context, filter models.MetricRollupFilter) ([]*models.MetricAggregate, error) {
+	columns := `d.id AS metric_id, d.key, d.name, d.unit, d.aggregation,
+		SUM(v.value) AS sum, AVG(v.value) AS average, MAX(v.value) AS max, MIN(v.value) AS min,
+		COUNT(DISTINCT v.event_id) AS event_count`
+	groupBy := "d.id, d.key, d.name, d.unit, d.aggregation"
+
+	query := r.db.WithContext(ctx).
+		Table("event_metric_values v").
+		Joins("JOIN impact_metric_definitions d ON d.id = v.metric_id").
+		Joins("JOIN events e ON e.id = v.event_id").
+		Where("e.status IN ?", []models.EventStatus{models.EventStatusActive, models.EventStatusComplete})
+
+	if filter.Interval != "" {
+		query = query.Select(columns+", date_trunc(?, e.date AT TIME ZONE e.time_zone) AS period", filter.Interval)
+		groupBy += ", period"
+	} else {
+		query = query.Select(columns)
+	}
+
+	if filter.OrganizationID != nil {
+		query = query.Where("e.organization_id = ? OR e.id IN (?)", *filter.OrganizationID, acceptedCohostEvents(r.db, *filter.OrganizationID))
+	}
+	if filter.Category != "" {
+		query = query.Where("LOWER(e.category) = LOWER(?)", filter.Category)
+	}
+	if !filter.From.IsZero() {
+		query = query.Where("e.date >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("e.date < ?", filter.To)
+	}
+
+	var aggregates []*models.MetricAggregate
+	if err := query.Group(groupBy).Order("d.name").Find(&aggregates).Error; err != nil {
+		return nil, err
+	}
+
+	return aggregates, nil
+}
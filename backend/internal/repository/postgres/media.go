@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"volunteer-management/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type MediaRepository struct {
+	db *gorm.DB
+}
+
+func NewMediaRepository(db *gorm.DB) *MediaRepository {
+	return &MediaRepository{db: db}
+}
+
+func (r *MediaRepository) Create(ctx context.Context, media *models.Media) error {
+	media.CreatedAt = time.Now()
+	return r.db.WithContext(ctx).Create(media).Error
+}
+
+func (r *MediaRepository) GetByID(ctx context.Context, id int64) (*models.Media, error) {
+	var media models.Media
+	result := r.db.WithContext(ctx).First(&media, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &media, nil
+}
+
+func (r *MediaRepository) Delete(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Delete(&models.Media{}, id).Error
+}
+
+// ListOrphaned returns uploads created before the cutoff that no event image,
+// organization logo or cover, or user avatar refers to.
+func (r *MediaRepository) ListOrphaned(ctx context.Context, createdBefore time.Time, limit int) ([]*models.Media, error) {
+	var media []*models.Media
+	result := r.db.WithContext(ctx).
+		Where("created_at < ?", createdBefore).
+		Where("NOT EXISTS (SELECT 1 FROM events WHERE " + mediaReferenced("events.image") + ")").
+		Where("NOT EXISTS (SELECT 1 FROM organizations WHERE " + mediaReferenced("organizations.logo") +
+			" OR " + mediaReferenced("organizations.cover_image") + ")").
+		Where("NOT EXISTS (SELECT 1 FROM users WHERE " + mediaReferenced("users.avatar") + ")").
+		Order("created_at").
+		Limit(limit).
+		Find(&media)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return media, nil
+}
+
+// mediaReferenced matches a URL column that points at the media row's path,
+// either relative or absolute and with or without a variant query.
+func mediaReferenced(column string) string {
+	return fmt.Sprintf("(%[1]s LIKE '%%/media/' || media.id OR %[1]s LIKE '%%/media/' || media.id || '?%%')", column)
+}
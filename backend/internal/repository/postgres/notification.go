@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"time"
+	"volunteer-management/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type NotificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+func (r *NotificationRepository) Create(ctx context.Context, input *models.CreateNotificationInput) (*models.Notification, error) {
+	notification := &models.Notification{
+		UserID:    input.UserID,
+		Type:      input.Type,
+		Title:     input.Title,
+		Message:   input.Message,
+		EventID:   input.EventID,
+		IsRead:    false,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	result := r.db.WithContext(ctx).Create(notification)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return notification, nil
+}
+
+func (r *NotificationRepository) GetByID(ctx context.Context, id int64) (*models.Notification, error) {
+	var notification models.Notification
+	result := r.db.WithContext(ctx).First(&notification, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &notification, nil
+}
+
+func (r *NotificationRepository) ListByUser(ctx context.Context, userID int64, unreadOnly bool, offset, limit int) ([]*models.Notification, error) {
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("is_read = ?", false)
+	}
+
+	var notifications []*models.Notification
+	result := query.
+		Offset(offset).
+		Limit(limit).
+		Order("created_at DESC").
+		Find(&notifications)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return notifications, nil
+}
+
+func (r *NotificationRepository) MarkAsRead(ctx context.Context, id int64) error {
+	result := r.db.WithContext(ctx).Model(&models.Notification{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"is_read":    true,
+			"updated_at": time.Now(),
+		})
+
+	return result.Error
+}
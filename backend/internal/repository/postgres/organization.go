@@ -204,13 +204,17 @@ func (r *OrganizationRepository) ListTopByImpact(ctx context.Context, limit int)
 	return topOrgs, nil
 }
 
+// GetStats counts every event the organization hosts or co-hosts. Volunteers
+// and hours count toward the host each registration is attributed to, which
+// is the primary organization unless a co-host was credited.
 func (r *OrganizationRepository) GetStats(ctx context.Context, orgID int64) (*models.OrganizationStatsResponse, error) {
 	var stats models.OrganizationStatsResponse
+	hosted := r.db.WithContext(ctx).Model(&models.Event{}).
+		Where("organization_id = ? OR id IN (?)", orgID, acceptedCohostEvents(r.db, orgID))
 
 	// Total events
 	var totalEvents int64
-	if err := r.db.WithContext(ctx).Model(&models.Event{}).
-		Where("organization_id = ?", orgID).
+	if err := hosted.Session(&gorm.Session{}).
 		Count(&totalEvents).Error; err != nil {
 		return nil, err
 	}
@@ -221,7 +225,7 @@ func (r *OrganizationRepository) GetStats(ctx context.Context, orgID int64) (*mo
 		SELECT COUNT(DISTINCT er.volunteer_id) 
 		FROM event_registrations er
 		JOIN events e ON er.event_id = e.id
-		WHERE e.organization_id = ?
+		WHERE COALESCE(er.host_organization_id, e.organization_id) = ?
 	`
 	if err := r.db.WithContext(ctx).Raw(query, orgID).Scan(&stats.TotalVolunteers).Error; err != nil {
 		return nil, err
@@ -232,7 +236,7 @@ func (r *OrganizationRepository) GetStats(ctx context.Context, orgID int64) (*mo
 		SELECT COALESCE(SUM(er.hours_logged), 0) 
 		FROM event_registrations er
 		JOIN events e ON er.event_id = e.id
-		WHERE e.organization_id = ?
+		WHERE COALESCE(er.host_organization_id, e.organization_id) = ?
 	`
 	if err := r.db.WithContext(ctx).Raw(query, orgID).Scan(&stats.TotalHours).Error; err != nil {
 		return nil, err
@@ -240,9 +244,8 @@ func (r *OrganizationRepository) GetStats(ctx context.Context, orgID int64) (*mo
 
 	// Active events (these go into ActiveEvents field)
 	var activeEvents int64
-	if err := r.db.WithContext(ctx).Model(&models.Event{}).
-		Where("organization_id = ? AND date >= ? AND status = ?",
-			orgID, time.Now(), "active").
+	if err := hosted.Session(&gorm.Session{}).
+		Where("date >= ? AND status = ?", time.Now(), "active").
 		Count(&activeEvents).Error; err != nil {
 		return nil, err
 	}
@@ -250,9 +253,8 @@ func (r *OrganizationRepository) GetStats(ctx context.Context, orgID int64) (*mo
 
 	// Past events (completed events go into PastEvents field)
 	var pastEvents int64
-	if err := r.db.WithContext(ctx).Model(&models.Event{}).
-		Where("organization_id = ? AND date < ? AND status = ?",
-			orgID, time.Now(), "completed").
+	if err := hosted.Session(&gorm.Session{}).
+		Where("date < ? AND status = ?", time.Now(), "completed").
 		Count(&pastEvents).Error; err != nil {
 		return nil, err
 	}
@@ -263,12 +265,27 @@ func (r *OrganizationRepository) GetStats(ctx context.Context, orgID int64) (*mo
 		SELECT COUNT(DISTINCT er.volunteer_id) 
 		FROM event_registrations er
 		JOIN events e ON er.event_id = e.id
-		WHERE e.organization_id = ? AND e.date >= ?
+		WHERE COALESCE(er.host_organization_id, e.organization_id) = ? AND e.date >= ?
 	`
 	if err := r.db.WithContext(ctx).Raw(query, orgID, time.Now().AddDate(0, -3, 0)).Scan(&stats.ActiveVolunteers).Error; err != nil {
 		return nil, err
 	}
 
+	// Groups that signed members up, and the volunteers they brought
+	query = `
+		SELECT COUNT(DISTINCT gs.group_id) AS participating_groups,
+			COUNT(DISTINCT gm.volunteer_id) AS group_volunteers
+		FROM group_signups gs
+		JOIN events e ON gs.event_id = e.id
+		JOIN group_signup_members gm ON gm.group_signup_id = gs.id AND gm.status = ?
+		JOIN event_registrations er ON er.id = gm.registration_id
+		WHERE COALESCE(er.host_organization_id, e.organization_id) = ?
+	`
+	if err := r.db.WithContext(ctx).Raw(query, models.GroupSignupMemberSignedUp, orgID).Row().
+		Scan(&stats.ParticipatingGroups, &stats.GroupVolunteers); err != nil {
+		return nil, err
+	}
+
 	return &stats, nil
 }
 
@@ -279,3 +296,26 @@ func (r *OrganizationRepository) VerifyOrganization(ctx context.Context, id int6
 
 	return result.Error
 }
+
+func (r *OrganizationRepository) SetTrusted(ctx context.Context, id int64, trusted bool) error {
+	result := r.db.WithContext(ctx).Model(&models.Organization{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"is_trusted": trusted,
+			"updated_at": time.Now(),
+		})
+
+	return result.Error
+}
+
+func (r *OrganizationRepository) SetSignupPolicy(ctx context.Context, id int64, input *models.SetSignupPolicyInput) error {
+	result := r.db.WithContext(ctx).Model(&models.Organization{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"min_reliability_score": input.MinReliabilityScore,
+			"approval_below_score":  input.ApprovalBelowScore,
+			"updated_at":            time.Now(),
+		})
+
+	return result.Error
+}
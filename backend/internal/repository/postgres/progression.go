@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+	"time"
+	"volunteer-management/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ProgressionRepository struct {
+	db *gorm.DB
+}
+
+func NewProgressionRepository(db *gorm.DB) *ProgressionRepository {
+	return &ProgressionRepository{db: db}
+}
+
+// GetStats collects what XP rules look at for one volunteer.
+func (r *ProgressionRepository) GetStats(ctx context.Context, volunteerID int64) (*models.ProgressionStats, error) {
+	db := r.db.WithContext(ctx)
+	stats := &models.ProgressionStats{}
+
+	if err := db.Raw(`SELECT COALESCE(total_hours, 0) FROM volunteers WHERE id = ?`, volunteerID).
+		Scan(&stats.TotalHours).Error; err != nil {
+		return nil, err
+	}
+
+	if err := db.Raw(`SELECT COUNT(*) `+attendedEvents, volunteerID).
+		Scan(&stats.EventsAttended).Error; err != nil {
+		return nil, err
+	}
+
+	var months []struct {
+		Month time.Time
+	}
+	if err := db.Raw(`SELECT DISTINCT date_trunc('month', COALESCE(e.start_time, e.date)) AS month `+attendedEvents+`
+		ORDER BY month`, volunteerID).
+		Scan(&months).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range months {
+		stats.ActiveMonths = append(stats.ActiveMonths, row.Month)
+	}
+
+	return stats, nil
+}
+
+func (r *ProgressionRepository) GetLevel(ctx context.Context, volunteerID int64) (*models.VolunteerLevel, error) {
+	var level models.VolunteerLevel
+	result := r.db.WithContext(ctx).Where("volunteer_id = ?", volunteerID).First(&level)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &level, nil
+}
+
+// SaveLevel creates or replaces the volunteer's stored level.
+func (r *ProgressionRepository) SaveLevel(ctx context.Context, level *models.VolunteerLevel) error {
+	level.UpdatedAt = time.Now()
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "volunteer_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"level", "xp", "rules_version", "updated_at"}),
+		}).
+		Create(level).Error
+}
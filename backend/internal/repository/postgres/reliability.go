@@ -0,0 +1,174 @@
+package postgres
+
+import (
+	"context"
+	"time"
+	"volunteer-management/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// reliabilityRecordsQuery lists the outcome of each event a volunteer was
+// committed to. Cancellations only count when made less than the late cancel
+// window before the start.
+const reliabilityRecordsQuery = `
+	SELECT e.id AS event_id, e.title AS event_title, e.start_time,
+		CASE er.status
+			WHEN '` + models.RegistrationStatusCancelled + `' THEN '` + string(models.ReliabilityOutcomeLateCancellation) + `'
+			ELSE er.status END AS outcome
+	FROM event_registrations er
+	JOIN events e ON e.id = er.event_id
+	WHERE er.volunteer_id = @volunteer
+		AND e.status <> '` + string(models.EventStatusCancelled) + `'
+		AND e.start_time >= @since AND e.start_time < @until
+		AND (er.status IN ('` + models.RegistrationStatusAttended + `', '` + models.RegistrationStatusNoShow + `')
+			OR (er.status = '` + models.RegistrationStatusCancelled + `'
+				AND er.cancelled_at IS NOT NULL AND er.cancelled_at > e.start_time - @late * INTERVAL '1 second'))
+	ORDER BY e.start_time DESC`
+
+type ReliabilityRepository struct {
+	db *gorm.DB
+}
+
+func NewReliabilityRepository(db *gorm.DB) *ReliabilityRepository {
+	return &ReliabilityRepository{db: db}
+}
+
+// ListRecords returns the outcomes of the volunteer's events that started
+// between since and until, newest first.
+func (r *ReliabilityRepository) ListRecords(ctx context.Context, volunteerID int64, since, until time.Time, lateCancelWindow time.Duration) ([]*models.ReliabilityRecord, error) {
+	var records []*models.ReliabilityRecord
+	result := r.db.WithContext(ctx).Raw(reliabilityRecordsQuery, map[string]interface{}{
+		"volunteer": volunteerID,
+		"since":     since,
+		"until":     until,
+		"late":      lateCancelWindow.Seconds(),
+	}).Scan(&records)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return records, nil
+}
+
+func (r *ReliabilityRepository) GetLatestReset(ctx context.Context, volunteerID int64) (*models.ReliabilityReset, error) {
+	var reset models.ReliabilityReset
+	result := r.db.WithContext(ctx).
+		Where("volunteer_id = ?", volunteerID).
+		Order("created_at DESC").
+		First(&reset)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &reset, nil
+}
+
+func (r *ReliabilityRepository) CreateReset(ctx context.Context, reset *models.ReliabilityReset) error {
+	reset.CreatedAt = time.Now()
+	return r.db.WithContext(ctx).Create(reset).Error
+}
+
+func (r *ReliabilityRepository) CreateAppeal(ctx context.Context, appeal *models.ReliabilityAppeal) error {
+	now := time.Now()
+	appeal.CreatedAt = now
+	appeal.UpdatedAt = now
+	return r.db.WithContext(ctx).Create(appeal).Error
+}
+
+func (r *ReliabilityRepository) GetAppeal(ctx context.Context, id int64) (*models.ReliabilityAppeal, error) {
+	var appeal models.ReliabilityAppeal
+	result := r.withEventTitle(ctx).Where("reliability_appeals.id = ?", id).First(&appeal)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &appeal, nil
+}
+
+func (r *ReliabilityRepository) GetAppealByEventAndVolunteer(ctx context.Context, eventID, volunteerID int64) (*models.ReliabilityAppeal, error) {
+	var appeal models.ReliabilityAppeal
+	result := r.db.WithContext(ctx).
+		Where("event_id = ? AND volunteer_id = ?", eventID, volunteerID).
+		First(&appeal)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &appeal, nil
+}
+
+func (r *ReliabilityRepository) ListAppealsByVolunteer(ctx context.Context, volunteerID int64) ([]*models.ReliabilityAppeal, error) {
+	var appeals []*models.ReliabilityAppeal
+	result := r.withEventTitle(ctx).
+		Where("reliability_appeals.volunteer_id = ?", volunteerID).
+		Order("reliability_appeals.created_at DESC").
+		Find(&appeals)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return appeals, nil
+}
+
+func (r *ReliabilityRepository) ListAppealsByStatus(ctx context.Context, status models.ReliabilityAppealStatus, offset, limit int) ([]*models.ReliabilityAppeal, error) {
+	var appeals []*models.ReliabilityAppeal
+	result := r.withEventTitle(ctx).
+		Where("reliability_appeals.status = ?", status).
+		Offset(offset).
+		Limit(limit).
+		Order("reliability_appeals.created_at").
+		Find(&appeals)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return appeals, nil
+}
+
+// ListExcusedEventIDs returns the events excused by the volunteer's accepted
+// appeals.
+func (r *ReliabilityRepository) ListExcusedEventIDs(ctx context.Context, volunteerID int64) ([]int64, error) {
+	var ids []int64
+	result := r.db.WithContext(ctx).
+		Model(&models.ReliabilityAppeal{}).
+		Where("volunteer_id = ? AND status = ?", volunteerID, models.ReliabilityAppealAccepted).
+		Pluck("event_id", &ids)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return ids, nil
+}
+
+// ResolveAppeal records an admin's decision on a pending appeal and returns
+// nil when the appeal does not exist or was already resolved.
+func (r *ReliabilityRepository) ResolveAppeal(ctx context.Context, id int64, status models.ReliabilityAppealStatus, note string, adminID int64) (*models.ReliabilityAppeal, error) {
+	now := time.Now()
+	result := r.db.WithContext(ctx).
+		Model(&models.ReliabilityAppeal{}).
+		Where("id = ? AND status = ?", id, models.ReliabilityAppealPending).
+		Updates(map[string]interface{}{
+			"status":          status,
+			"resolution_note": note,
+			"resolved_by":     adminID,
+			"resolved_at":     now,
+			"updated_at":      now,
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	return r.GetAppeal(ctx, id)
+}
+
+func (r *ReliabilityRepository) withEventTitle(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Model(&models.ReliabilityAppeal{}).
+		Select("reliability_appeals.*, e.title AS event_title").
+		Joins("JOIN events e ON e.id = reliability_appeals.event_id")
+}
@@ -0,0 +1,227 @@
+package postgres
+
+import (
+	"context"
+	"time"
+	"volunteer-management/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SchoolRepository struct {
+	db *gorm.DB
+}
+
+func NewSchoolRepository(db *gorm.DB) *SchoolRepository {
+	return &SchoolRepository{db: db}
+}
+
+func (r *SchoolRepository) Create(ctx context.Context, school *models.School) error {
+	now := time.Now()
+	school.CreatedAt = now
+	school.UpdatedAt = now
+	return r.db.WithContext(ctx).Create(school).Error
+}
+
+func (r *SchoolRepository) GetByID(ctx context.Context, id int64) (*models.School, error) {
+	var school models.School
+	result := r.db.WithContext(ctx).First(&school, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &school, nil
+}
+
+func (r *SchoolRepository) GetByUserID(ctx context.Context, userID int64) (*models.School, error) {
+	var school models.School
+	result := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&school)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &school, nil
+}
+
+func (r *SchoolRepository) Update(ctx context.Context, school *models.School) error {
+	school.UpdatedAt = time.Now()
+	return r.db.WithContext(ctx).Save(school).Error
+}
+
+func (r *SchoolRepository) List(ctx context.Context, offset, limit int) ([]*models.School, error) {
+	var schools []*models.School
+	result := r.db.WithContext(ctx).
+		Order("name").
+		Offset(offset).
+		Limit(limit).
+		Find(&schools)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return schools, nil
+}
+
+func (r *SchoolRepository) CreateTerm(ctx context.Context, term *models.SchoolTerm) error {
+	now := time.Now()
+	term.CreatedAt = now
+	term.UpdatedAt = now
+	return r.db.WithContext(ctx).Create(term).Error
+}
+
+func (r *SchoolRepository) GetTerm(ctx context.Context, id int64) (*models.SchoolTerm, error) {
+	var term models.SchoolTerm
+	result := r.db.WithContext(ctx).First(&term, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &term, nil
+}
+
+func (r *SchoolRepository) UpdateTerm(ctx context.Context, term *models.SchoolTerm) error {
+	term.UpdatedAt = time.Now()
+	return r.db.WithContext(ctx).Save(term).Error
+}
+
+func (r *SchoolRepository) DeleteTerm(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Delete(&models.SchoolTerm{}, id).Error
+}
+
+// ListTerms returns the school's terms, most recent first.
+func (r *SchoolRepository) ListTerms(ctx context.Context, schoolID int64) ([]*models.SchoolTerm, error) {
+	var terms []*models.SchoolTerm
+	result := r.db.WithContext(ctx).
+		Where("school_id = ?", schoolID).
+		Order("start_date DESC").
+		Find(&terms)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return terms, nil
+}
+
+// students selects student links with the school and volunteer names.
+func (r *SchoolRepository) students(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Model(&models.SchoolStudent{}).
+		Select("school_students.*, s.name AS school_name, u.name AS volunteer_name").
+		Joins("JOIN schools s ON s.id = school_students.school_id").
+		Joins("JOIN volunteers v ON v.id = school_students.volunteer_id").
+		Joins("JOIN users u ON u.id = v.user_id")
+}
+
+func (r *SchoolRepository) GetStudent(ctx context.Context, id int64) (*models.SchoolStudent, error) {
+	var student models.SchoolStudent
+	result := r.students(ctx).Where("school_students.id = ?", id).First(&student)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &student, nil
+}
+
+func (r *SchoolRepository) GetStudentByVolunteer(ctx context.Context, schoolID, volunteerID int64) (*models.SchoolStudent, error) {
+	var student models.SchoolStudent
+	result := r.students(ctx).
+		Where("school_students.school_id = ? AND school_students.volunteer_id = ?", schoolID, volunteerID).
+		First(&student)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &student, nil
+}
+
+// SaveStudent creates the volunteer's link to the school, or replaces it when
+// they ask again after a rejection.
+func (r *SchoolRepository) SaveStudent(ctx context.Context, student *models.SchoolStudent) error {
+	now := time.Now()
+	if student.ID == 0 {
+		student.CreatedAt = now
+	}
+	student.UpdatedAt = now
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "school_id"}, {Name: "volunteer_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"student_number", "status", "decision_note", "decided_by", "decided_at", "updated_at",
+			}),
+		}).
+		Create(student).Error
+}
+
+func (r *SchoolRepository) DeleteStudent(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Delete(&models.SchoolStudent{}, id).Error
+}
+
+// ListStudents returns the school's student links, optionally with one
+// status, by name.
+func (r *SchoolRepository) ListStudents(ctx context.Context, schoolID int64, status models.SchoolStudentStatus, offset, limit int) ([]*models.SchoolStudent, error) {
+	var students []*models.SchoolStudent
+	query := r.students(ctx).Where("school_students.school_id = ?", schoolID)
+	if status != "" {
+		query = query.Where("school_students.status = ?", status)
+	}
+	result := query.
+		Order("u.name").
+		Offset(offset).
+		Limit(limit).
+		Find(&students)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return students, nil
+}
+
+func (r *SchoolRepository) ListVolunteerSchools(ctx context.Context, volunteerID int64) ([]*models.SchoolStudent, error) {
+	var students []*models.SchoolStudent
+	result := r.students(ctx).
+		Where("school_students.volunteer_id = ?", volunteerID).
+		Order("s.name").
+		Find(&students)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return students, nil
+}
+
+// ListTermProgress sums each approved student's approved hours for events
+// whose local date falls within the term. Students without hours are included
+// with none.
+func (r *SchoolRepository) ListTermProgress(ctx context.Context, schoolID int64, term *models.SchoolTerm) ([]*models.StudentProgress, error) {
+	var progress []*models.StudentProgress
+	err := r.db.WithContext(ctx).Raw(`SELECT v.id AS volunteer_id, u.name, u.email, ss.student_number,
+			COALESCE(SUM(hs.approved_hours), 0) AS approved_hours
+		FROM school_students ss
+		JOIN volunteers v ON v.id = ss.volunteer_id
+		JOIN users u ON u.id = v.user_id
+		LEFT JOIN (hour_submissions hs
+			JOIN events e ON e.id = hs.event_id
+			AND (COALESCE(e.start_time, e.date) AT TIME ZONE e.time_zone)::date BETWEEN @start AND @end)
+		ON hs.volunteer_id = ss.volunteer_id
+		AND hs.status IN ('`+string(models.HoursStatusApproved)+`', '`+string(models.HoursStatusAdjusted)+`', '`+string(models.HoursStatusDisputed)+`')
+		WHERE ss.school_id = @school AND ss.status = '`+string(models.SchoolStudentApproved)+`'
+		GROUP BY v.id, u.name, u.email, ss.student_number
+		ORDER BY u.name`,
+		map[string]interface{}{
+			"school": schoolID,
+			"start":  term.StartDate.Format("2006-01-02"),
+			"end":    term.EndDate.Format("2006-01-02"),
+		}).
+		Scan(&progress).Error
+	if err != nil {
+		return nil, err
+	}
+	return progress, nil
+}
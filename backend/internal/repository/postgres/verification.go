@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"time"
+	"volunteer-management/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// organizationSignups selects the signups for events an organization hosts or
+// is credited with, leaving out volunteers who dropped out.
+const organizationSignups = `FROM event_registrations er
+	JOIN events e ON e.id = er.event_id
+	WHERE (e.organization_id = @org OR er.host_organization_id = @org)
+	AND er.status NOT IN ('` + models.RegistrationStatusDeclined + `', '` + models.RegistrationStatusCancelled + `', '` + models.RegistrationStatusCancelledByOrganizer + `')`
+
+type VerificationRepository struct {
+	db *gorm.DB
+}
+
+func NewVerificationRepository(db *gorm.DB) *VerificationRepository {
+	return &VerificationRepository{db: db}
+}
+
+func (r *VerificationRepository) CreateDocument(ctx context.Context, document *models.VerificationDocument) error {
+	now := time.Now()
+	document.CreatedAt = now
+	document.UpdatedAt = now
+	return r.db.WithContext(ctx).Create(document).Error
+}
+
+func (r *VerificationRepository) GetDocument(ctx context.Context, id int64) (*models.VerificationDocument, error) {
+	var document models.VerificationDocument
+	result := r.db.WithContext(ctx).First(&document, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &document, nil
+}
+
+func (r *VerificationRepository) ListDocuments(ctx context.Context, volunteerID int64) ([]*models.VerificationDocument, error) {
+	var documents []*models.VerificationDocument
+	result := r.db.WithContext(ctx).
+		Where("volunteer_id = ?", volunteerID).
+		Order("created_at DESC").
+		Find(&documents)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return documents, nil
+}
+
+// ListPendingDocuments returns documents awaiting review, oldest first. With
+// an organization it only returns documents of that organization's volunteers.
+func (r *VerificationRepository) ListPendingDocuments(ctx context.Context, organizationID *int64, offset, limit int) ([]*models.VerificationDocument, error) {
+	var documents []*models.VerificationDocument
+	query := r.db.WithContext(ctx).
+		Where("status = ?", models.VerificationDocumentPending)
+	if organizationID != nil {
+		query = query.Where("EXISTS (SELECT 1 "+organizationSignups+" AND er.volunteer_id = verification_documents.volunteer_id)", map[string]interface{}{"org": *organizationID})
+	}
+	result := query.
+		Order("created_at").
+		Offset(offset).
+		Limit(limit).
+		Find(&documents)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return documents, nil
+}
+
+// ReviewDocument records the reviewer's decision on the document.
+func (r *VerificationRepository) ReviewDocument(ctx context.Context, document *models.VerificationDocument) error {
+	document.UpdatedAt = time.Now()
+	return r.db.WithContext(ctx).
+		Model(&models.VerificationDocument{}).
+		Where("id = ?", document.ID).
+		Updates(map[string]interface{}{
+			"status":                   document.Status,
+			"level":                    document.Level,
+			"expires_at":               document.ExpiresAt,
+			"review_note":              document.ReviewNote,
+			"reviewed_by":              document.ReviewedBy,
+			"reviewer_organization_id": document.ReviewerOrganizationID,
+			"reviewed_at":              document.ReviewedAt,
+			"updated_at":               document.UpdatedAt,
+		}).Error
+}
+
+func (r *VerificationRepository) DeleteDocument(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Delete(&models.VerificationDocument{}, id).Error
+}
+
+func (r *VerificationRepository) SetVolunteerLevel(ctx context.Context, volunteerID int64, level models.VerificationLevel) error {
+	return r.db.WithContext(ctx).
+		Model(&models.Volunteer{}).
+		Where("id = ?", volunteerID).
+		Updates(map[string]interface{}{
+			"verification_level": level,
+			"updated_at":         time.Now(),
+		}).Error
+}
+
+// IsOrganizationVolunteer reports whether the volunteer signed up for one of
+// the organization's events.
+func (r *VerificationRepository) IsOrganizationVolunteer(ctx context.Context, organizationID, volunteerID int64) (bool, error) {
+	var exists bool
+	err := r.db.WithContext(ctx).Raw(`SELECT EXISTS (SELECT 1 `+organizationSignups+` AND er.volunteer_id = @volunteer)`,
+		map[string]interface{}{"volunteer": volunteerID, "org": organizationID}).
+		Scan(&exists).Error
+	return exists, err
+}
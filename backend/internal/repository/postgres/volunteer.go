@@ -18,12 +18,14 @@ func NewVolunteerRepository(db *gorm.DB) *VolunteerRepository {
 
 func (r *VolunteerRepository) Create(ctx context.Context, input *models.CreateVolunteerInput) (*models.Volunteer, error) {
 	volunteer := &models.Volunteer{
-		UserID:       input.UserID,
-		Skills:       input.Skills,
-		Availability: input.Availability,
-		Bio:          input.Bio,
-		CreatedAt:    time.Now(),
-		UpdatedAt:    time.Now(),
+		UserID:            input.UserID,
+		Skills:            input.Skills,
+		Availability:      input.Availability,
+		TimeZone:          models.DefaultVolunteerTimeZone,
+		Bio:               input.Bio,
+		VerificationLevel: models.VerificationNone,
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
 	}
 
 	result := r.db.WithContext(ctx).Create(volunteer)
@@ -106,3 +108,16 @@ func (r *VolunteerRepository) List(ctx context.Context, offset, limit int) ([]*m
 
 	return volunteers, nil
 }
+
+// ListAchievements returns the volunteer's awarded badges, newest first.
+func (r *VolunteerRepository) ListAchievements(ctx context.Context, volunteerID int64) ([]*models.Achievement, error) {
+	var achievements []*models.Achievement
+	result := r.db.WithContext(ctx).
+		Where("volunteer_id = ?", volunteerID).
+		Order("awarded_date DESC, id DESC").
+		Find(&achievements)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return achievements, nil
+}
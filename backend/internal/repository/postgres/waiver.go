@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"time"
+	"volunteer-management/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type WaiverRepository struct {
+	db *gorm.DB
+}
+
+func NewWaiverRepository(db *gorm.DB) *WaiverRepository {
+	return &WaiverRepository{db: db}
+}
+
+// Create stores the waiver together with its first version.
+func (r *WaiverRepository) Create(ctx context.Context, organizationID int64, title string, version *models.WaiverVersion) (*models.Waiver, error) {
+	waiver := &models.Waiver{
+		OrganizationID: organizationID,
+		Title:          title,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(waiver).Error; err != nil {
+			return err
+		}
+
+		version.WaiverID = waiver.ID
+		version.Version = 1
+		version.CreatedAt = time.Now()
+		return tx.Create(version).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	waiver.CurrentVersion = version
+	return waiver, nil
+}
+
+func (r *WaiverRepository) GetByID(ctx context.Context, id int64) (*models.Waiver, error) {
+	var waiver models.Waiver
+	result := r.db.WithContext(ctx).First(&waiver, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &waiver, nil
+}
+
+func (r *WaiverRepository) ListByOrganization(ctx context.Context, organizationID int64) ([]*models.Waiver, error) {
+	var waivers []*models.Waiver
+	result := r.db.WithContext(ctx).
+		Where("organization_id = ?", organizationID).
+		Order("created_at DESC").
+		Find(&waivers)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return waivers, nil
+}
+
+// CreateVersion appends a new version to the waiver.
+func (r *WaiverRepository) CreateVersion(ctx context.Context, version *models.WaiverVersion) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current int
+		if err := tx.Model(&models.WaiverVersion{}).
+			Where("waiver_id = ?", version.WaiverID).
+			Select("COALESCE(MAX(version), 0)").
+			Scan(&current).Error; err != nil {
+			return err
+		}
+
+		version.Version = current + 1
+		version.CreatedAt = time.Now()
+		if err := tx.Create(version).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&models.Waiver{}).
+			Where("id = ?", version.WaiverID).
+			Update("updated_at", time.Now()).Error
+	})
+}
+
+func (r *WaiverRepository) GetVersion(ctx context.Context, id int64) (*models.WaiverVersion, error) {
+	var version models.WaiverVersion
+	result := r.db.WithContext(ctx).First(&version, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &version, nil
+}
+
+func (r *WaiverRepository) GetCurrentVersion(ctx context.Context, waiverID int64) (*models.WaiverVersion, error) {
+	var version models.WaiverVersion
+	result := r.db.WithContext(ctx).
+		Where("waiver_id = ?", waiverID).
+		Order("version DESC").
+		First(&version)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &version, nil
+}
+
+func (r *WaiverRepository) CreateSignature(ctx context.Context, signature *models.WaiverSignature) error {
+	signature.CreatedAt = time.Now()
+	return r.db.WithContext(ctx).Create(signature).Error
+}
+
+func (r *WaiverRepository) GetSignature(ctx context.Context, id int64) (*models.WaiverSignature, error) {
+	var signature models.WaiverSignature
+	result := r.db.WithContext(ctx).First(&signature, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &signature, nil
+}
+
+func (r *WaiverRepository) GetSignatureByVersionAndVolunteer(ctx context.Context, versionID, volunteerID int64) (*models.WaiverSignature, error) {
+	var signature models.WaiverSignature
+	result := r.db.WithContext(ctx).
+		Where("waiver_version_id = ? AND volunteer_id = ?", versionID, volunteerID).
+		First(&signature)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &signature, nil
+}
+
+func (r *WaiverRepository) ListSignaturesByVolunteer(ctx context.Context, volunteerID int64) ([]*models.WaiverSignature, error) {
+	var signatures []*models.WaiverSignature
+	result := r.db.WithContext(ctx).
+		Where("volunteer_id = ?", volunteerID).
+		Order("signed_at DESC").
+		Find(&signatures)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return signatures, nil
+}
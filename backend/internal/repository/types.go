@@ -7,7 +7,8 @@ import (
 )
 
 type SearchParams struct {
-	Date     time.Time
+	DateFrom time.Time // local calendar days, inclusive
+	DateTo   time.Time
 	Location string
 	Skills   []string
 	Status   string
@@ -16,6 +17,17 @@ type SearchParams struct {
 	Limit    int
 }
 
+// AvailabilityParams asks which volunteers are free for the whole of a shift.
+type AvailabilityParams struct {
+	Start          time.Time
+	End            time.Time
+	Skills         []string // any of
+	Location       string
+	ExcludeEventID int64 // leaves out volunteers already registered for the event
+	Offset         int
+	Limit          int
+}
+
 // User repositories
 type UserRepository interface {
 	Create(ctx context.Context, input *models.CreateUserInput) (*models.User, error)
@@ -33,11 +45,16 @@ type EventRepository interface {
 	Create(ctx context.Context, input *models.CreateEventInput, organizationID int64) (*models.Event, error)
 	GetByID(ctx context.Context, id int64) (*models.Event, error)
 	Update(ctx context.Context, id int64, input *models.UpdateEventInput) (*models.Event, error)
+	Cancel(ctx context.Context, id int64, message string, input *models.UpdateEventInput) (*models.Event, []*models.EventRegistration, error)
+	Review(ctx context.Context, id int64, status models.EventStatus, note string, reviewerID int64) (*models.Event, error)
+	ListPendingReview(ctx context.Context, offset, limit int) ([]*models.Event, error)
+	SetWaiver(ctx context.Context, id int64, waiverID *int64) error
 	Delete(ctx context.Context, id int64) error
 	List(ctx context.Context, offset, limit int) ([]*models.Event, error)
 	ListByOrganization(ctx context.Context, organizationID int64, offset, limit int) ([]*models.Event, error)
 	ListByStatus(ctx context.Context, status models.EventStatus, offset, limit int) ([]*models.Event, error)
 	ListByVolunteer(ctx context.Context, volunteerID int64, offset, limit int) ([]*models.Event, error)
+	ListCommitments(ctx context.Context, volunteerID int64, from, to time.Time) ([]*models.ScheduleEntry, error)
 	Search(ctx context.Context, params SearchParams) ([]*models.Event, error)
 	CountByStatus(ctx context.Context) (map[models.EventStatus]int, error)
 	ListUpcoming(ctx context.Context, limit int) ([]*models.Event, error)
@@ -72,18 +89,252 @@ type OrganizationRepository interface {
 	ListTopByImpact(ctx context.Context, limit int) ([]*models.TopOrganization, error)
 	GetStats(ctx context.Context, orgID int64) (*models.OrganizationStatsResponse, error)
 	VerifyOrganization(ctx context.Context, id int64) error
+	SetTrusted(ctx context.Context, id int64, trusted bool) error
+	SetSignupPolicy(ctx context.Context, id int64, input *models.SetSignupPolicyInput) error
 }
 
 // Event Registration repositories
 type EventRegistrationRepository interface {
-	Create(ctx context.Context, input *models.CreateEventRegistrationInput) (*models.EventRegistration, error)
+	Create(ctx context.Context, input *models.CreateEventRegistrationInput, signature *models.WaiverSignature) (*models.EventRegistration, error)
 	GetByID(ctx context.Context, id int64) (*models.EventRegistration, error)
 	GetByEventAndVolunteer(ctx context.Context, eventID, volunteerID int64) (*models.EventRegistration, error)
 	Update(ctx context.Context, id int64, input *models.UpdateEventRegistrationInput) (*models.EventRegistration, error)
-	Delete(ctx context.Context, id int64) error
 	ListByEvent(ctx context.Context, eventID int64, offset, limit int) ([]*models.EventRegistration, error)
+	ListByEventAndStatus(ctx context.Context, eventID int64, status string, offset, limit int) ([]*models.EventRegistration, error)
 	ListByVolunteer(ctx context.Context, volunteerID int64, offset, limit int) ([]*models.EventRegistration, error)
 	CountByEvent(ctx context.Context, eventID int64) (int, error)
+	Decide(ctx context.Context, registration *models.EventRegistration) (bool, error)
+	ListAttendees(ctx context.Context, eventID int64) ([]*models.Attendee, error)
+	HasRegisteredWith(ctx context.Context, volunteerID, organizationID int64) (bool, error)
+	RecordAttendance(ctx context.Context, eventID int64, updates []*models.AttendanceUpdate, source string, recordedBy int64) error
+}
+
+// Event form repositories
+type EventFormRepository interface {
+	Create(ctx context.Context, eventID int64, questions []models.FormQuestion, createdBy int64) (*models.EventForm, error)
+	GetByID(ctx context.Context, id int64) (*models.EventForm, error)
+	GetCurrent(ctx context.Context, eventID int64) (*models.EventForm, error)
+	ListByEvent(ctx context.Context, eventID int64) ([]*models.EventForm, error)
+}
+
+// Event co-host repositories
+type EventCohostRepository interface {
+	Invite(ctx context.Context, eventID, organizationID, invitedBy int64) (*models.EventCohost, error)
+	Get(ctx context.Context, eventID, organizationID int64) (*models.EventCohost, error)
+	ListByEvent(ctx context.Context, eventID int64) ([]*models.EventCohost, error)
+	ListPendingByOrganization(ctx context.Context, organizationID int64) ([]*models.EventCohost, error)
+	Respond(ctx context.Context, eventID, organizationID int64, status models.CohostStatus) (*models.EventCohost, error)
+	Delete(ctx context.Context, eventID, organizationID int64) error
+}
+
+// Impact metric repositories
+type ImpactMetricRepository interface {
+	Create(ctx context.Context, input *models.CreateImpactMetricInput) (*models.ImpactMetricDefinition, error)
+	GetByID(ctx context.Context, id int64) (*models.ImpactMetricDefinition, error)
+	GetByKey(ctx context.Context, key string) (*models.ImpactMetricDefinition, error)
+	Update(ctx context.Context, id int64, input *models.UpdateImpactMetricInput) (*models.ImpactMetricDefinition, error)
+	List(ctx context.Context, activeOnly bool) ([]*models.ImpactMetricDefinition, error)
+	ListEventValues(ctx context.Context, eventID int64) ([]*models.EventMetricValue, error)
+	SetEventValues(ctx context.Context, eventID int64, values map[int64]float64, remove []int64, recordedBy int64) error
+	Aggregate(ctx context.Context, filter models.MetricRollupFilter) ([]*models.MetricAggregate, error)
+}
+
+// Feedback repositories
+type FeedbackRepository interface {
+	Save(ctx context.Context, feedback *models.EventFeedback) (*models.EventFeedback, error)
+	GetByID(ctx context.Context, id int64) (*models.EventFeedback, error)
+	GetByEventAndVolunteer(ctx context.Context, eventID, volunteerID int64) (*models.EventFeedback, error)
+	ListByEvent(ctx context.Context, eventID int64, visibleOnly bool, offset, limit int) ([]*models.EventFeedback, error)
+	ListByStatus(ctx context.Context, status models.FeedbackStatus, offset, limit int) ([]*models.EventFeedback, error)
+	Report(ctx context.Context, id, userID int64, threshold int) (*models.EventFeedback, error)
+	Moderate(ctx context.Context, id int64, status models.FeedbackStatus, note string, moderatorID int64) (*models.EventFeedback, error)
+	GetParticipant(ctx context.Context, eventID, volunteerID int64) (*models.EventParticipant, error)
+	ListParticipants(ctx context.Context, eventID int64) ([]*models.EventParticipant, error)
+	SaveVolunteerRating(ctx context.Context, rating *models.VolunteerRating) (*models.VolunteerRating, error)
+	ListVolunteerRatings(ctx context.Context, volunteerID int64) ([]*models.VolunteerRating, error)
+	GetOrganizationReport(ctx context.Context, orgID int64, filter models.FeedbackReportFilter, commentLimit int) (*models.FeedbackReport, error)
+}
+
+// Volunteer group repositories
+type GroupRepository interface {
+	Create(ctx context.Context, input *models.CreateGroupInput, leaderID int64) (*models.VolunteerGroup, error)
+	GetByID(ctx context.Context, id int64) (*models.VolunteerGroup, error)
+	Update(ctx context.Context, id int64, input *models.UpdateGroupInput) (*models.VolunteerGroup, error)
+	Delete(ctx context.Context, id int64) error
+	ListByVolunteer(ctx context.Context, volunteerID int64) ([]*models.VolunteerGroup, error)
+	ListMembers(ctx context.Context, groupID int64) ([]*models.GroupMember, error)
+	GetMember(ctx context.Context, groupID, volunteerID int64) (*models.GroupMember, error)
+	SetMemberRole(ctx context.Context, groupID, volunteerID int64, role models.GroupRole) error
+	RemoveMember(ctx context.Context, groupID, volunteerID int64) error
+	CountLeaders(ctx context.Context, groupID int64) (int, error)
+	CreateInvitations(ctx context.Context, invitations []*models.GroupInvitation) error
+	GetInvitation(ctx context.Context, id int64) (*models.GroupInvitation, error)
+	GetInvitationByTokenHash(ctx context.Context, tokenHash string) (*models.GroupInvitation, error)
+	ListInvitations(ctx context.Context, groupID int64) ([]*models.GroupInvitation, error)
+	RevokeInvitation(ctx context.Context, id int64) error
+	AcceptInvitation(ctx context.Context, invitation *models.GroupInvitation, volunteerID int64) (bool, error)
+	CreateSignup(ctx context.Context, groupSignup *models.GroupSignup, members []*models.GroupSignupMember, template *models.EventRegistration) (int, error)
+	GetSignup(ctx context.Context, id int64) (*models.GroupSignup, error)
+	ListSignups(ctx context.Context, groupID int64) ([]*models.GroupSignup, error)
+	RecordAttendance(ctx context.Context, groupSignup *models.GroupSignup, entries []models.GroupAttendanceEntry, recordedBy int64) error
+}
+
+// Hours repositories
+type HoursRepository interface {
+	Create(ctx context.Context, submission *models.HourSubmission, change *models.HoursChange) error
+	Update(ctx context.Context, submission *models.HourSubmission, change *models.HoursChange) error
+	GetByID(ctx context.Context, id int64) (*models.HourSubmission, error)
+	GetByEventAndVolunteer(ctx context.Context, eventID, volunteerID int64) (*models.HourSubmission, error)
+	ListByVolunteer(ctx context.Context, volunteerID int64, offset, limit int) ([]*models.HourSubmission, error)
+	ListByEvent(ctx context.Context, eventID, organizationID int64, offset, limit int) ([]*models.HourSubmission, error)
+	ListByOrganizationAndStatus(ctx context.Context, organizationID int64, statuses []models.HoursStatus, offset, limit int) ([]*models.HourSubmission, error)
+}
+
+// Reliability repositories
+type ReliabilityRepository interface {
+	ListRecords(ctx context.Context, volunteerID int64, since, until time.Time, lateCancelWindow time.Duration) ([]*models.ReliabilityRecord, error)
+	GetLatestReset(ctx context.Context, volunteerID int64) (*models.ReliabilityReset, error)
+	CreateReset(ctx context.Context, reset *models.ReliabilityReset) error
+	CreateAppeal(ctx context.Context, appeal *models.ReliabilityAppeal) error
+	GetAppeal(ctx context.Context, id int64) (*models.ReliabilityAppeal, error)
+	GetAppealByEventAndVolunteer(ctx context.Context, eventID, volunteerID int64) (*models.ReliabilityAppeal, error)
+	ListAppealsByVolunteer(ctx context.Context, volunteerID int64) ([]*models.ReliabilityAppeal, error)
+	ListAppealsByStatus(ctx context.Context, status models.ReliabilityAppealStatus, offset, limit int) ([]*models.ReliabilityAppeal, error)
+	ListExcusedEventIDs(ctx context.Context, volunteerID int64) ([]int64, error)
+	ResolveAppeal(ctx context.Context, id int64, status models.ReliabilityAppealStatus, note string, adminID int64) (*models.ReliabilityAppeal, error)
+}
+
+// Availability repositories
+type AvailabilityRepository interface {
+	SetTimeZone(ctx context.Context, volunteerID int64, timeZone string) error
+	ListWindows(ctx context.Context, volunteerID int64) ([]*models.AvailabilityWindow, error)
+	GetWindow(ctx context.Context, id int64) (*models.AvailabilityWindow, error)
+	CreateWindow(ctx context.Context, window *models.AvailabilityWindow) error
+	UpdateWindow(ctx context.Context, window *models.AvailabilityWindow) error
+	DeleteWindow(ctx context.Context, id int64) error
+	ListBlackouts(ctx context.Context, volunteerID int64) ([]*models.AvailabilityBlackout, error)
+	GetBlackout(ctx context.Context, id int64) (*models.AvailabilityBlackout, error)
+	CreateBlackout(ctx context.Context, blackout *models.AvailabilityBlackout) error
+	UpdateBlackout(ctx context.Context, blackout *models.AvailabilityBlackout) error
+	DeleteBlackout(ctx context.Context, id int64) error
+	ListAvailableVolunteers(ctx context.Context, params *AvailabilityParams) ([]*models.Volunteer, error)
+}
+
+// Badge repositories
+type BadgeRepository interface {
+	Create(ctx context.Context, badge *models.Badge) error
+	GetByID(ctx context.Context, id int64) (*models.Badge, error)
+	Update(ctx context.Context, badge *models.Badge) error
+	Delete(ctx context.Context, id int64) error
+	List(ctx context.Context, activeOnly bool) ([]*models.Badge, error)
+	GetStats(ctx context.Context, volunteerID int64) (*models.BadgeStats, error)
+	Award(ctx context.Context, achievement *models.Achievement) (bool, error)
+}
+
+// Verification repositories
+type VerificationRepository interface {
+	CreateDocument(ctx context.Context, document *models.VerificationDocument) error
+	GetDocument(ctx context.Context, id int64) (*models.VerificationDocument, error)
+	ListDocuments(ctx context.Context, volunteerID int64) ([]*models.VerificationDocument, error)
+	ListPendingDocuments(ctx context.Context, organizationID *int64, offset, limit int) ([]*models.VerificationDocument, error)
+	ReviewDocument(ctx context.Context, document *models.VerificationDocument) error
+	DeleteDocument(ctx context.Context, id int64) error
+	SetVolunteerLevel(ctx context.Context, volunteerID int64, level models.VerificationLevel) error
+	IsOrganizationVolunteer(ctx context.Context, organizationID, volunteerID int64) (bool, error)
+}
+
+// Certificate repositories
+type CertificateRepository interface {
+	ListApprovedHours(ctx context.Context, volunteerID int64) ([]*models.ServiceHoursEntry, error)
+	GetApprovedHours(ctx context.Context, eventID, volunteerID int64) (*models.ServiceHoursEntry, error)
+	Create(ctx context.Context, document *models.IssuedDocument) error
+	GetByCode(ctx context.Context, code string) (*models.IssuedDocument, error)
+}
+
+// School repositories
+type SchoolRepository interface {
+	Create(ctx context.Context, school *models.School) error
+	GetByID(ctx context.Context, id int64) (*models.School, error)
+	GetByUserID(ctx context.Context, userID int64) (*models.School, error)
+	Update(ctx context.Context, school *models.School) error
+	List(ctx context.Context, offset, limit int) ([]*models.School, error)
+
+	CreateTerm(ctx context.Context, term *models.SchoolTerm) error
+	GetTerm(ctx context.Context, id int64) (*models.SchoolTerm, error)
+	UpdateTerm(ctx context.Context, term *models.SchoolTerm) error
+	DeleteTerm(ctx context.Context, id int64) error
+	ListTerms(ctx context.Context, schoolID int64) ([]*models.SchoolTerm, error)
+
+	GetStudent(ctx context.Context, id int64) (*models.SchoolStudent, error)
+	GetStudentByVolunteer(ctx context.Context, schoolID, volunteerID int64) (*models.SchoolStudent, error)
+	SaveStudent(ctx context.Context, student *models.SchoolStudent) error
+	DeleteStudent(ctx context.Context, id int64) error
+	ListStudents(ctx context.Context, schoolID int64, status models.SchoolStudentStatus, offset, limit int) ([]*models.SchoolStudent, error)
+	ListVolunteerSchools(ctx context.Context, volunteerID int64) ([]*models.SchoolStudent, error)
+	ListTermProgress(ctx context.Context, schoolID int64, term *models.SchoolTerm) ([]*models.StudentProgress, error)
+}
+
+// Follow, announcement and activity feed repositories
+type FeedRepository interface {
+	Follow(ctx context.Context, follow *models.OrganizationFollow) error
+	Unfollow(ctx context.Context, organizationID, volunteerID int64) error
+	GetFollow(ctx context.Context, organizationID, volunteerID int64) (*models.OrganizationFollow, error)
+	ListFollowing(ctx context.Context, volunteerID int64) ([]*models.OrganizationFollow, error)
+	ListFollowers(ctx context.Context, organizationID int64, offset, limit int) ([]*models.OrganizationFollower, error)
+
+	CreateAnnouncement(ctx context.Context, announcement *models.Announcement) error
+	GetAnnouncement(ctx context.Context, id int64) (*models.Announcement, error)
+	DeleteAnnouncement(ctx context.Context, id int64) error
+	ListAnnouncements(ctx context.Context, organizationID *int64, offset, limit int) ([]*models.Announcement, error)
+
+	ListFeed(ctx context.Context, volunteerID, userID int64, cursor *models.FeedCursor, limit int) ([]*models.FeedItem, error)
+}
+
+// Emergency info repositories
+type EmergencyInfoRepository interface {
+	Get(ctx context.Context, volunteerID int64) (*models.EmergencyInfoRecord, error)
+	Save(ctx context.Context, record *models.EmergencyInfoRecord) error
+	Delete(ctx context.Context, volunteerID int64) error
+	ListByVolunteers(ctx context.Context, volunteerIDs []int64) ([]*models.EmergencyInfoRecord, error)
+	LogAccess(ctx context.Context, accesses []*models.EmergencyInfoAccess) error
+	ListAccesses(ctx context.Context, volunteerID int64, offset, limit int) ([]*models.EmergencyInfoAccess, error)
+}
+
+// Progression repositories
+type ProgressionRepository interface {
+	GetStats(ctx context.Context, volunteerID int64) (*models.ProgressionStats, error)
+	GetLevel(ctx context.Context, volunteerID int64) (*models.VolunteerLevel, error)
+	SaveLevel(ctx context.Context, level *models.VolunteerLevel) error
+}
+
+// Waiver repositories
+type WaiverRepository interface {
+	Create(ctx context.Context, organizationID int64, title string, version *models.WaiverVersion) (*models.Waiver, error)
+	GetByID(ctx context.Context, id int64) (*models.Waiver, error)
+	ListByOrganization(ctx context.Context, organizationID int64) ([]*models.Waiver, error)
+	CreateVersion(ctx context.Context, version *models.WaiverVersion) error
+	GetVersion(ctx context.Context, id int64) (*models.WaiverVersion, error)
+	GetCurrentVersion(ctx context.Context, waiverID int64) (*models.WaiverVersion, error)
+	CreateSignature(ctx context.Context, signature *models.WaiverSignature) error
+	GetSignature(ctx context.Context, id int64) (*models.WaiverSignature, error)
+	GetSignatureByVersionAndVolunteer(ctx context.Context, versionID, volunteerID int64) (*models.WaiverSignature, error)
+	ListSignaturesByVolunteer(ctx context.Context, volunteerID int64) ([]*models.WaiverSignature, error)
+}
+
+// Media repositories
+type MediaRepository interface {
+	Create(ctx context.Context, media *models.Media) error
+	GetByID(ctx context.Context, id int64) (*models.Media, error)
+	Delete(ctx context.Context, id int64) error
+	ListOrphaned(ctx context.Context, createdBefore time.Time, limit int) ([]*models.Media, error)
+}
+
+// Notification repositories
+type NotificationRepository interface {
+	Create(ctx context.Context, input *models.CreateNotificationInput) (*models.Notification, error)
+	GetByID(ctx context.Context, id int64) (*models.Notification, error)
+	ListByUser(ctx context.Context, userID int64, unreadOnly bool, offset, limit int) ([]*models.Notification, error)
+	MarkAsRead(ctx context.Context, id int64) error
 }
 
 // Message repositories
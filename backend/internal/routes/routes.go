@@ -14,6 +14,14 @@ func SetupRoutes(router *gin.Engine, handlers *handlers.Handlers, authMiddleware
 	router.POST("/api/auth/refresh-token", authMiddleware.AuthRequired(), handlers.Auth.RefreshToken)
 	router.POST("/api/auth/logout", authMiddleware.AuthRequired(), handlers.Auth.Logout)
 
+	// Signed URLs are only issued to signed-in users; the files they point at
+	// need no session
+	router.GET("/media/:id", authMiddleware.AuthRequired(), handlers.Media.Redirect)
+	router.GET("/media/files/*key", handlers.Media.ServeFile)
+
+	// Transcripts and certificates are checked by schools without an account
+	router.GET("/api/certificates/verify/:code", handlers.Certificate.Verify)
+
 	// Protected routes
 	auth := router.Group("/api")
 	auth.Use(authMiddleware.AuthRequired())
@@ -27,11 +35,55 @@ func SetupRoutes(router *gin.Engine, handlers *handlers.Handlers, authMiddleware
 		auth.GET("/events", handlers.Event.List)
 		auth.GET("/events/:id", handlers.Event.GetByID)
 		auth.GET("/events/search", handlers.Event.Search)
+		auth.GET("/events/recommended", roleMiddleware.RequireRole("volunteer"), handlers.Recommend.ListRecommendedEvents)
 
 		// Admin/Organization only event routes
 		auth.POST("/events", roleMiddleware.RequireRole("admin", "organization"), handlers.Event.Create)
 		auth.PUT("/events/:id", roleMiddleware.RequireRole("admin", "organization"), handlers.Event.Update)
 		auth.DELETE("/events/:id", roleMiddleware.RequireRole("admin", "organization"), handlers.Event.Delete)
+		auth.POST("/events/:id/cancel", roleMiddleware.RequireRole("admin", "organization"), handlers.Event.Cancel)
+
+		// Event registration form routes
+		auth.GET("/events/:id/form", handlers.EventForm.GetCurrent)
+		auth.PUT("/events/:id/form", roleMiddleware.RequireRole("organization"), handlers.EventForm.Save)
+		auth.GET("/events/:id/form/versions", roleMiddleware.RequireRole("organization"), handlers.EventForm.ListVersions)
+		auth.GET("/events/:id/form/answers", roleMiddleware.RequireRole("organization"), handlers.EventForm.ExportAnswers)
+
+		// Event co-host routes
+		auth.GET("/events/:id/cohosts", handlers.EventCohost.List)
+		auth.POST("/events/:id/cohosts", roleMiddleware.RequireRole("organization"), handlers.EventCohost.Invite)
+		auth.PUT("/events/:id/cohosts/respond", roleMiddleware.RequireRole("organization"), handlers.EventCohost.Respond)
+		auth.DELETE("/events/:id/cohosts/:org_id", roleMiddleware.RequireRole("organization"), handlers.EventCohost.Remove)
+		auth.GET("/organizations/cohost-invitations", roleMiddleware.RequireRole("organization"), handlers.EventCohost.ListInvitations)
+
+		// Event waiver routes
+		auth.GET("/events/:id/waiver", handlers.Waiver.GetForEvent)
+		auth.PUT("/events/:id/waiver", roleMiddleware.RequireRole("organization"), handlers.Waiver.AttachToEvent)
+
+		// Event feedback routes
+		auth.GET("/events/:id/feedback", handlers.Feedback.ListByEvent)
+		auth.POST("/events/:id/feedback", roleMiddleware.RequireRole("volunteer"), handlers.Feedback.Submit)
+		auth.GET("/events/:id/feedback/mine", roleMiddleware.RequireRole("volunteer"), handlers.Feedback.GetMine)
+		auth.PUT("/events/:id/volunteers/:volunteer_id/rating", roleMiddleware.RequireRole("organization"), handlers.Feedback.RateVolunteer)
+		auth.POST("/feedback/:id/report", handlers.Feedback.Report)
+
+		// Event attendance routes
+		auth.PUT("/events/:id/attendance", roleMiddleware.RequireRole("organization"), handlers.Attendance.Record)
+		auth.POST("/events/:id/attendance/sheet", roleMiddleware.RequireRole("organization"), handlers.Attendance.ImportSheet)
+
+		// Event hours routes
+		auth.POST("/events/:id/hours", roleMiddleware.RequireRole("volunteer"), handlers.Hours.Submit)
+		auth.GET("/events/:id/hours", roleMiddleware.RequireRole("organization"), handlers.Hours.ListByEvent)
+
+		// Event impact metric routes
+		auth.GET("/events/:id/metrics", handlers.ImpactMetric.ListEventValues)
+		auth.PUT("/events/:id/metrics", roleMiddleware.RequireRole("organization"), handlers.ImpactMetric.RecordEventValues)
+
+		// Admin only event moderation routes
+		auth.GET("/events/review-queue", roleMiddleware.RequireRole("admin"), handlers.Event.ListPendingReview)
+		auth.PUT("/events/:id/review", roleMiddleware.RequireRole("admin"), handlers.Event.Review)
+		auth.GET("/feedback/moderation-queue", roleMiddleware.RequireRole("admin"), handlers.Feedback.ListModerationQueue)
+		auth.PUT("/feedback/:id/moderate", roleMiddleware.RequireRole("admin"), handlers.Feedback.Moderate)
 
 		// Signup routes
 		auth.POST("/signups", handlers.Signup.Create)
@@ -39,6 +91,48 @@ func SetupRoutes(router *gin.Engine, handlers *handlers.Handlers, authMiddleware
 		auth.GET("/signups/volunteer/:volunteer_id", handlers.Signup.ListByVolunteer)
 		auth.PUT("/signups/:id", handlers.Signup.Update)
 		auth.DELETE("/signups/:id", handlers.Signup.Delete)
+		auth.PUT("/signups/:id/decision", roleMiddleware.RequireRole("organization"), handlers.Signup.Decide)
+		auth.GET("/events/:id/signups/pending", roleMiddleware.RequireRole("organization"), handlers.Signup.ListPending)
+
+		// Volunteer group routes
+		auth.POST("/groups", roleMiddleware.RequireRole("volunteer"), handlers.Group.Create)
+		auth.GET("/groups", roleMiddleware.RequireRole("volunteer"), handlers.Group.ListMine)
+		auth.GET("/groups/:id", handlers.Group.GetByID)
+		auth.PUT("/groups/:id", roleMiddleware.RequireRole("volunteer"), handlers.Group.Update)
+		auth.DELETE("/groups/:id", roleMiddleware.RequireRole("volunteer"), handlers.Group.Delete)
+		auth.POST("/groups/:id/invitations", roleMiddleware.RequireRole("volunteer"), handlers.Group.Invite)
+		auth.GET("/groups/:id/invitations", roleMiddleware.RequireRole("volunteer"), handlers.Group.ListInvitations)
+		auth.DELETE("/groups/:id/invitations/:invitation_id", roleMiddleware.RequireRole("volunteer"), handlers.Group.RevokeInvitation)
+		auth.POST("/groups/invitations/accept", roleMiddleware.RequireRole("volunteer"), handlers.Group.AcceptInvitation)
+		auth.PUT("/groups/:id/members/:volunteer_id", roleMiddleware.RequireRole("volunteer"), handlers.Group.SetMemberRole)
+		auth.DELETE("/groups/:id/members/:volunteer_id", roleMiddleware.RequireRole("volunteer"), handlers.Group.RemoveMember)
+		auth.POST("/groups/:id/signups", roleMiddleware.RequireRole("volunteer"), handlers.Group.SignUp)
+		auth.GET("/groups/:id/signups", roleMiddleware.RequireRole("volunteer"), handlers.Group.ListSignups)
+		auth.GET("/group-signups/:id", handlers.Group.GetSignup)
+		auth.PUT("/group-signups/:id/attendance", roleMiddleware.RequireRole("organization"), handlers.Group.RecordAttendance)
+
+		// Hours review routes
+		auth.GET("/hours/mine", roleMiddleware.RequireRole("volunteer"), handlers.Hours.ListMine)
+		auth.GET("/hours/review-queue", roleMiddleware.RequireRole("organization"), handlers.Hours.ListReviewQueue)
+		auth.GET("/hours/:id", handlers.Hours.GetByID)
+		auth.PUT("/hours/:id/review", roleMiddleware.RequireRole("organization"), handlers.Hours.Review)
+		auth.POST("/hours/:id/dispute", roleMiddleware.RequireRole("volunteer"), handlers.Hours.Dispute)
+
+		// Transcript and certificate routes
+		auth.POST("/volunteers/me/transcript", roleMiddleware.RequireRole("volunteer"), handlers.Certificate.Transcript)
+		auth.POST("/events/:id/volunteers/:volunteer_id/certificate", roleMiddleware.RequireRole("organization"), handlers.Certificate.Certificate)
+
+		// Waiver routes
+		auth.POST("/waivers", roleMiddleware.RequireRole("organization"), handlers.Waiver.Create)
+		auth.GET("/waivers", roleMiddleware.RequireRole("organization"), handlers.Waiver.List)
+		auth.GET("/waivers/:id", handlers.Waiver.GetByID)
+		auth.POST("/waivers/:id/versions", roleMiddleware.RequireRole("organization"), handlers.Waiver.AddVersion)
+		auth.GET("/waivers/signatures/:id/pdf", handlers.Waiver.DownloadSignature)
+
+		// Impact metric definition routes
+		auth.GET("/impact-metrics", handlers.ImpactMetric.List)
+		auth.POST("/impact-metrics", roleMiddleware.RequireRole("admin"), handlers.ImpactMetric.Create)
+		auth.PUT("/impact-metrics/:id", roleMiddleware.RequireRole("admin"), handlers.ImpactMetric.Update)
 
 		// Organization routes
 		auth.GET("/organizations", handlers.Organization.ListOrganizations)
@@ -47,17 +141,103 @@ func SetupRoutes(router *gin.Engine, handlers *handlers.Handlers, authMiddleware
 		auth.PUT("/organizations/:id/profile", roleMiddleware.RequireRole("organization"), handlers.Organization.UpdateOrganizationProfile)
 		auth.GET("/organizations/:id/stats", handlers.Organization.GetOrganizationStats)
 		auth.GET("/organizations/:id/events", handlers.Organization.ListOrganizationEvents)
+		auth.GET("/organizations/:id/feedback-report", roleMiddleware.RequireRole("admin", "organization"), handlers.Feedback.GetReport)
+
+		// Follow, announcement and feed routes
+		auth.GET("/feed", roleMiddleware.RequireRole("volunteer"), handlers.Feed.Feed)
+		auth.POST("/organizations/:id/follow", roleMiddleware.RequireRole("volunteer"), handlers.Feed.Follow)
+		auth.DELETE("/organizations/:id/follow", roleMiddleware.RequireRole("volunteer"), handlers.Feed.Unfollow)
+		auth.GET("/volunteers/me/following", roleMiddleware.RequireRole("volunteer"), handlers.Feed.ListFollowing)
+		auth.GET("/organizations/:id/followers", roleMiddleware.RequireRole("admin", "organization"), handlers.Feed.ListFollowers)
+		auth.GET("/organizations/:id/announcements", handlers.Feed.ListAnnouncements)
+		auth.POST("/organizations/:id/announcements", roleMiddleware.RequireRole("admin", "organization"), handlers.Feed.CreateAnnouncement)
+		auth.GET("/announcements", handlers.Feed.ListAnnouncements)
+		auth.POST("/announcements", roleMiddleware.RequireRole("admin"), handlers.Feed.CreateAnnouncement)
+		auth.DELETE("/announcements/:id", roleMiddleware.RequireRole("admin", "organization"), handlers.Feed.DeleteAnnouncement)
 
 		// Admin only organization routes
 		auth.GET("/organizations/pending", roleMiddleware.RequireRole("admin"), handlers.Organization.ListPendingOrganizations)
 		auth.PUT("/organizations/:id/verify", roleMiddleware.RequireRole("admin"), handlers.Organization.VerifyOrganization)
+		auth.PUT("/organizations/:id/trust", roleMiddleware.RequireRole("admin"), handlers.Organization.SetTrusted)
+		auth.PUT("/organizations/:id/signup-policy", roleMiddleware.RequireRole("organization"), handlers.Organization.SetSignupPolicy)
+
+		// School and student program routes
+		auth.GET("/schools", handlers.School.List)
+		auth.POST("/schools", roleMiddleware.RequireRole("admin"), handlers.School.Create)
+		auth.GET("/schools/mine", roleMiddleware.RequireRole("school_admin"), handlers.School.GetMine)
+		auth.GET("/schools/:id", handlers.School.Get)
+		auth.PUT("/schools/:id", roleMiddleware.RequireRole("admin", "school_admin"), handlers.School.Update)
+		auth.GET("/schools/:id/terms", handlers.School.ListTerms)
+		auth.POST("/schools/:id/terms", roleMiddleware.RequireRole("admin", "school_admin"), handlers.School.CreateTerm)
+		auth.PUT("/schools/:id/terms/:term_id", roleMiddleware.RequireRole("admin", "school_admin"), handlers.School.UpdateTerm)
+		auth.DELETE("/schools/:id/terms/:term_id", roleMiddleware.RequireRole("admin", "school_admin"), handlers.School.DeleteTerm)
+		auth.GET("/schools/:id/terms/:term_id/progress", roleMiddleware.RequireRole("admin", "school_admin"), handlers.School.TermReport)
+		auth.POST("/schools/:id/students", roleMiddleware.RequireRole("volunteer"), handlers.School.RequestLink)
+		auth.GET("/schools/:id/students", roleMiddleware.RequireRole("admin", "school_admin"), handlers.School.ListStudents)
+		auth.PUT("/schools/:id/students/:link_id", roleMiddleware.RequireRole("admin", "school_admin"), handlers.School.DecideStudent)
+		auth.GET("/volunteers/me/schools", roleMiddleware.RequireRole("volunteer"), handlers.School.ListMine)
+		auth.DELETE("/volunteers/me/schools/:id", roleMiddleware.RequireRole("volunteer"), handlers.School.Unlink)
 
 		// Volunteer routes
 		auth.GET("/volunteers", roleMiddleware.RequireRole("admin"), handlers.Volunteer.List)
 		auth.GET("/volunteers/:id", handlers.Volunteer.GetByID)
+		auth.GET("/volunteers/me/schedule", roleMiddleware.RequireRole("volunteer"), handlers.Signup.GetMySchedule)
 		auth.POST("/volunteers", handlers.Volunteer.Create)
 		auth.PUT("/volunteers/:id", handlers.Volunteer.Update)
 		auth.DELETE("/volunteers/:id", roleMiddleware.RequireRole("admin"), handlers.Volunteer.Delete)
+		auth.GET("/volunteers/:id/ratings", roleMiddleware.RequireRole("admin", "organization"), handlers.Feedback.GetVolunteerRatings)
+
+		// Availability routes
+		auth.GET("/volunteers/me/availability", roleMiddleware.RequireRole("volunteer"), handlers.Availability.GetMine)
+		auth.PUT("/volunteers/me/availability/time-zone", roleMiddleware.RequireRole("volunteer"), handlers.Availability.SetTimeZone)
+		auth.POST("/volunteers/me/availability/windows", roleMiddleware.RequireRole("volunteer"), handlers.Availability.AddWindow)
+		auth.PUT("/volunteers/me/availability/windows/:id", roleMiddleware.RequireRole("volunteer"), handlers.Availability.UpdateWindow)
+		auth.DELETE("/volunteers/me/availability/windows/:id", roleMiddleware.RequireRole("volunteer"), handlers.Availability.DeleteWindow)
+		auth.POST("/volunteers/me/availability/blackouts", roleMiddleware.RequireRole("volunteer"), handlers.Availability.AddBlackout)
+		auth.PUT("/volunteers/me/availability/blackouts/:id", roleMiddleware.RequireRole("volunteer"), handlers.Availability.UpdateBlackout)
+		auth.DELETE("/volunteers/me/availability/blackouts/:id", roleMiddleware.RequireRole("volunteer"), handlers.Availability.DeleteBlackout)
+		auth.GET("/volunteers/:id/availability", roleMiddleware.RequireRole("admin", "organization"), handlers.Availability.GetForVolunteer)
+		auth.GET("/volunteers/available", roleMiddleware.RequireRole("admin", "organization"), handlers.Availability.ListAvailable)
+		auth.GET("/events/:id/available-volunteers", roleMiddleware.RequireRole("organization"), handlers.Availability.ListAvailableForEvent)
+
+		// Verification routes
+		auth.GET("/volunteers/me/verification", roleMiddleware.RequireRole("volunteer"), handlers.Verification.GetMine)
+		auth.POST("/volunteers/me/verification/documents", roleMiddleware.RequireRole("volunteer"), handlers.Verification.Upload)
+		auth.DELETE("/volunteers/me/verification/documents/:id", roleMiddleware.RequireRole("volunteer"), handlers.Verification.Withdraw)
+		auth.GET("/volunteers/:id/verification", roleMiddleware.RequireRole("admin", "organization"), handlers.Verification.GetForVolunteer)
+		auth.GET("/verification/documents/pending", roleMiddleware.RequireRole("admin", "organization"), handlers.Verification.ListPending)
+		auth.GET("/verification/documents/:id/file", handlers.Verification.Download)
+		auth.PUT("/verification/documents/:id/review", roleMiddleware.RequireRole("admin", "organization"), handlers.Verification.Review)
+
+		// Emergency contact and medical info routes
+		auth.GET("/volunteers/me/emergency-info", roleMiddleware.RequireRole("volunteer"), handlers.Emergency.GetMine)
+		auth.PUT("/volunteers/me/emergency-info", roleMiddleware.RequireRole("volunteer"), handlers.Emergency.SaveMine)
+		auth.DELETE("/volunteers/me/emergency-info", roleMiddleware.RequireRole("volunteer"), handlers.Emergency.DeleteMine)
+		auth.GET("/volunteers/me/emergency-info/accesses", roleMiddleware.RequireRole("volunteer"), handlers.Emergency.ListMyAccesses)
+		auth.GET("/events/:id/emergency-info", roleMiddleware.RequireRole("organization"), handlers.Emergency.ListForEvent)
+		auth.GET("/events/:id/volunteers/:volunteer_id/emergency-info", roleMiddleware.RequireRole("organization"), handlers.Emergency.GetForEventVolunteer)
+
+		// Badge routes
+		auth.GET("/badges", handlers.Badge.List)
+		auth.POST("/badges", roleMiddleware.RequireRole("admin"), handlers.Badge.Create)
+		auth.PUT("/badges/:id", roleMiddleware.RequireRole("admin"), handlers.Badge.Update)
+		auth.DELETE("/badges/:id", roleMiddleware.RequireRole("admin"), handlers.Badge.Delete)
+		auth.GET("/volunteers/me/badges", roleMiddleware.RequireRole("volunteer"), handlers.Badge.GetMyProgress)
+		auth.GET("/volunteers/:id/achievements", handlers.Badge.ListAchievements)
+
+		// Level and XP routes
+		auth.GET("/volunteers/me/progress", roleMiddleware.RequireRole("volunteer"), handlers.Progression.GetMine)
+		auth.GET("/volunteers/:id/progress", handlers.Progression.GetProgress)
+		auth.POST("/progression/recompute", roleMiddleware.RequireRole("admin"), handlers.Progression.Recompute)
+
+		// Reliability score routes
+		auth.GET("/volunteers/me/reliability", roleMiddleware.RequireRole("volunteer"), handlers.Reliability.GetMine)
+		auth.GET("/volunteers/:id/reliability", roleMiddleware.RequireRole("admin", "organization"), handlers.Reliability.GetForVolunteer)
+		auth.POST("/volunteers/:id/reliability/reset", roleMiddleware.RequireRole("admin"), handlers.Reliability.Reset)
+		auth.POST("/reliability/appeals", roleMiddleware.RequireRole("volunteer"), handlers.Reliability.Appeal)
+		auth.GET("/reliability/appeals/mine", roleMiddleware.RequireRole("volunteer"), handlers.Reliability.ListMyAppeals)
+		auth.GET("/reliability/appeals", roleMiddleware.RequireRole("admin"), handlers.Reliability.ListPendingAppeals)
+		auth.PUT("/reliability/appeals/:id", roleMiddleware.RequireRole("admin"), handlers.Reliability.ResolveAppeal)
 
 		// Message routes
 		auth.POST("/messages", handlers.Message.SendMessage)
@@ -68,6 +248,14 @@ func SetupRoutes(router *gin.Engine, handlers *handlers.Handlers, authMiddleware
 		auth.PUT("/messages/:id/read", handlers.Message.MarkAsRead)
 		auth.PUT("/messages/conversations/:id/read-all", handlers.Message.MarkAllAsRead)
 
+		// Media upload routes
+		auth.POST("/media", handlers.Media.Upload)
+		auth.GET("/media/:id", handlers.Media.GetByID)
+
+		// Notification routes
+		auth.GET("/notifications", handlers.Notification.List)
+		auth.PUT("/notifications/:id/read", handlers.Notification.MarkAsRead)
+
 		// Analytics routes
 		auth.GET("/analytics/admin/dashboard", roleMiddleware.RequireRole("admin"), handlers.Analytics.GetAdminDashboardStats)
 		auth.GET("/analytics/recent-activity", handlers.Analytics.GetRecentActivity)
@@ -79,6 +267,9 @@ func SetupRoutes(router *gin.Engine, handlers *handlers.Handlers, authMiddleware
 		auth.GET("/analytics/organization-stats", handlers.Analytics.GetOrganizationStats)
 		auth.GET("/analytics/organization-event-stats", handlers.Analytics.GetOrganizationEventStats)
 		auth.GET("/analytics/volunteer-stats", handlers.Analytics.GetVolunteerStats)
+		auth.GET("/analytics/impact/organizations/:id", handlers.Analytics.GetOrganizationImpact)
+		auth.GET("/analytics/impact/categories/:category", handlers.Analytics.GetCategoryImpact)
+		auth.GET("/analytics/impact/platform", handlers.Analytics.GetPlatformImpact)
 	}
 
 	// WebSocket route
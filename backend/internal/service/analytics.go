@@ -2,16 +2,25 @@ package service
 
 import (
 	"context"
+	"errors"
+	"sort"
 	"volunteer-management/internal/models"
 	"volunteer-management/internal/repository"
 )
 
+var (
+	ErrInvalidRollupInterval = errors.New("interval must be day, week, month or year")
+)
+
+var rollupIntervals = map[string]bool{"day": true, "week": true, "month": true, "year": true}
+
 type AnalyticsService struct {
 	analyticsRepo    repository.AnalyticsRepository
 	userRepo         repository.UserRepository
 	eventRepo        repository.EventRepository
 	organizationRepo repository.OrganizationRepository
 	volunteerRepo    repository.VolunteerRepository
+	metricRepo       repository.ImpactMetricRepository
 }
 
 func NewAnalyticsService(
@@ -19,13 +28,15 @@ func NewAnalyticsService(
 	userRepo repository.UserRepository,
 	eventRepo repository.EventRepository,
 	organizationRepo repository.OrganizationRepository,
-	volunteerRepo repository.VolunteerRepository) *AnalyticsService {
+	volunteerRepo repository.VolunteerRepository,
+	metricRepo repository.ImpactMetricRepository) *AnalyticsService {
 	return &AnalyticsService{
 		analyticsRepo:    analyticsRepo,
 		userRepo:         userRepo,
 		eventRepo:        eventRepo,
 		organizationRepo: organizationRepo,
 		volunteerRepo:    volunteerRepo,
+		metricRepo:       metricRepo,
 	}
 }
 
@@ -80,3 +91,76 @@ func (s *AnalyticsService) GetVolunteerStats(ctx context.Context, volunteerID in
 func (s *AnalyticsService) GetPendingOrganizations(ctx context.Context, limit int) ([]*models.PendingOrganization, error) {
 	return s.analyticsRepo.GetPendingOrganizations(ctx, limit)
 }
+
+// GetImpactRollup rolls impact metrics up over the events matching the filter,
+// combining each metric with its own aggregation. When the filter has an
+// interval each metric also carries a time series.
+func (s *AnalyticsService) GetImpactRollup(ctx context.Context, filter models.MetricRollupFilter) ([]*models.MetricRollup, error) {
+	if filter.Interval != "" && !rollupIntervals[filter.Interval] {
+		return nil, ErrInvalidRollupInterval
+	}
+
+	interval := filter.Interval
+	filter.Interval = ""
+	totals, err := s.metricRepo.Aggregate(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	rollups := make([]*models.MetricRollup, 0, len(totals))
+	byMetric := make(map[int64]*models.MetricRollup, len(totals))
+	for _, total := range totals {
+		rollup := &models.MetricRollup{
+			Key:         total.Key,
+			Name:        total.Name,
+			Unit:        total.Unit,
+			Aggregation: total.Aggregation,
+			Value:       aggregateValue(total),
+			EventCount:  total.EventCount,
+		}
+		rollups = append(rollups, rollup)
+		byMetric[total.MetricID] = rollup
+	}
+
+	if interval == "" {
+		return rollups, nil
+	}
+
+	filter.Interval = interval
+	periods, err := s.metricRepo.Aggregate(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, period := range periods {
+		rollup, ok := byMetric[period.MetricID]
+		if !ok || period.Period == nil {
+			continue
+		}
+		rollup.Series = append(rollup.Series, &models.MetricRollupPoint{
+			Period:     *period.Period,
+			Value:      aggregateValue(period),
+			EventCount: period.EventCount,
+		})
+	}
+	for _, rollup := range rollups {
+		sort.Slice(rollup.Series, func(i, j int) bool {
+			return rollup.Series[i].Period.Before(rollup.Series[j].Period)
+		})
+	}
+
+	return rollups, nil
+}
+
+// aggregateValue picks the aggregate the metric's definition asks for.
+func aggregateValue(aggregate *models.MetricAggregate) float64 {
+	switch aggregate.Aggregation {
+	case models.MetricAggregationAverage:
+		return aggregate.Average
+	case models.MetricAggregationMax:
+		return aggregate.Max
+	case models.MetricAggregationMin:
+		return aggregate.Min
+	default:
+		return aggregate.Sum
+	}
+}
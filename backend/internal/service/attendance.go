@@ -0,0 +1,315 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+)
+
+var (
+	ErrAttendanceBeforeEvent = errors.New("attendance can be recorded once the event has started")
+	ErrInvalidSheet          = errors.New("the sign-in sheet needs a header row with an email or volunteer_id column")
+	ErrSheetTooLong          = fmt.Errorf("a sign-in sheet can have at most %d rows", maxAttendanceRows)
+)
+
+// maxAttendanceRows matches the limit on JSON bulk updates.
+const maxAttendanceRows = 1000
+
+// attendanceRow is an entry with its position for the report and any problem
+// found while reading it.
+type attendanceRow struct {
+	models.AttendanceEntry
+	row     int
+	problem string
+}
+
+type AttendanceService struct {
+	eventRegRepo       repository.EventRegistrationRepository
+	eventService       *EventService
+	volunteerService   *VolunteerService
+	badgeService       *BadgeService
+	progressionService *ProgressionService
+}
+
+func NewAttendanceService(
+	eventRegRepo repository.EventRegistrationRepository,
+	eventService *EventService,
+	volunteerService *VolunteerService,
+	badgeService *BadgeService,
+	progressionService *ProgressionService) *AttendanceService {
+	return &AttendanceService{
+		eventRegRepo:       eventRegRepo,
+		eventService:       eventService,
+		volunteerService:   volunteerService,
+		badgeService:       badgeService,
+		progressionService: progressionService,
+	}
+}
+
+// Record marks the listed volunteers attended or no-show for an event hosted
+// by the caller's organization. Co-hosts only record volunteers credited to
+// them.
+func (s *AttendanceService) Record(ctx context.Context, eventID int64, input *models.BulkAttendanceInput, userID int64) (*models.BulkAttendanceReport, error) {
+	event, org, err := s.checkEvent(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]*attendanceRow, len(input.Entries))
+	for i, entry := range input.Entries {
+		rows[i] = &attendanceRow{AttendanceEntry: entry, row: i + 1}
+	}
+	return s.apply(ctx, event, org, rows, "bulk attendance", userID)
+}
+
+// ImportSheet records attendance from a CSV sign-in sheet. The header row
+// names the columns: email or volunteer_id to identify the volunteer, and
+// optionally status (attended when blank) and hours (the event's length when
+// blank). Rows are reported by line number.
+func (s *AttendanceService) ImportSheet(ctx context.Context, eventID int64, sheet io.Reader, userID int64) (*models.BulkAttendanceReport, error) {
+	event, org, err := s.checkEvent(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := readSignInSheet(sheet)
+	if err != nil {
+		return nil, err
+	}
+	return s.apply(ctx, event, org, rows, "sign-in sheet", userID)
+}
+
+// checkEvent returns the event and the caller's organization, which must host
+// it.
+func (s *AttendanceService) checkEvent(ctx context.Context, eventID, userID int64) (*models.Event, *models.Organization, error) {
+	event, err := s.eventService.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+	org, err := s.eventService.checkEventEditor(ctx, event, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if time.Now().Before(event.StartTime) {
+		return nil, nil, ErrAttendanceBeforeEvent
+	}
+	return event, org, nil
+}
+
+// apply resolves every row against the event's attendees and records them in
+// one transaction. Hours are approved on behalf of the host each volunteer is
+// credited to, so org may only record its own volunteers. When any row has a
+// problem nothing is recorded and the report explains each failure.
+func (s *AttendanceService) apply(ctx context.Context, event *models.Event, org *models.Organization, rows []*attendanceRow, source string, userID int64) (*models.BulkAttendanceReport, error) {
+	attendees, err := s.eventRegRepo.ListAttendees(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*models.Attendee, len(attendees))
+	byEmail := make(map[string]*models.Attendee, len(attendees))
+	for _, attendee := range attendees {
+		byID[attendee.VolunteerID] = attendee
+		byEmail[strings.ToLower(attendee.Email)] = attendee
+	}
+
+	defaultHours := eventLengthHours(event)
+	report := &models.BulkAttendanceReport{EventID: event.ID}
+	updates := make([]*models.AttendanceUpdate, 0, len(rows))
+	seen := make(map[int64]int, len(rows))
+
+	for _, row := range rows {
+		result := &models.AttendanceRowResult{
+			Row:         row.row,
+			VolunteerID: row.VolunteerID,
+			Email:       row.Email,
+			Status:      row.Status,
+		}
+		report.Rows = append(report.Rows, result)
+
+		attendee, problem := resolveAttendee(row, byID, byEmail)
+		if problem == "" {
+			result.VolunteerID = attendee.VolunteerID
+			result.Name = attendee.Name
+			result.Email = attendee.Email
+			organizationID := event.OrganizationID
+			if attendee.HostOrganizationID != nil {
+				organizationID = *attendee.HostOrganizationID
+			}
+			if first, ok := seen[attendee.VolunteerID]; ok {
+				problem = fmt.Sprintf("volunteer is already listed on row %d", first)
+			} else if organizationID != org.ID {
+				problem = "volunteer is credited to another host of this event"
+			}
+			seen[attendee.VolunteerID] = row.row
+		}
+
+		hours := 0.0
+		if problem == "" {
+			switch {
+			case row.Status == models.RegistrationStatusNoShow && row.Hours != nil && *row.Hours > 0:
+				problem = "a no-show cannot be credited hours"
+			case row.Status == models.RegistrationStatusAttended && row.Hours != nil:
+				hours = *row.Hours
+			case row.Status == models.RegistrationStatusAttended:
+				hours = defaultHours
+			}
+		}
+
+		if problem != "" {
+			result.Error = problem
+			report.Failed++
+			continue
+		}
+		if row.Status == models.RegistrationStatusAttended {
+			result.Hours = &hours
+		}
+		updates = append(updates, &models.AttendanceUpdate{
+			VolunteerID:        attendee.VolunteerID,
+			HostOrganizationID: attendee.HostOrganizationID,
+			Status:             row.Status,
+			Hours:              hours,
+		})
+	}
+
+	if report.Failed > 0 {
+		return report, nil
+	}
+
+	if len(updates) > 0 {
+		if err := s.eventRegRepo.RecordAttendance(ctx, event.ID, updates, source, userID); err != nil {
+			return nil, err
+		}
+	}
+	for _, update := range updates {
+		if err := s.volunteerService.cacheService.DeleteVolunteer(ctx, update.VolunteerID); err != nil {
+			// logger.Error("Failed to invalidate volunteer cache", err)
+		}
+		if update.Status == models.RegistrationStatusAttended {
+			if _, err := s.badgeService.Evaluate(ctx, update.VolunteerID); err != nil {
+				// logger.Error("Failed to evaluate badges", err)
+			}
+			if err := s.progressionService.Update(ctx, update.VolunteerID); err != nil {
+				// logger.Error("Failed to update volunteer level", err)
+			}
+		}
+	}
+
+	report.Applied = true
+	report.Updated = len(updates)
+	return report, nil
+}
+
+// resolveAttendee finds the attendee a row refers to, preferring the
+// volunteer ID over the email, or explains why it cannot.
+func resolveAttendee(row *attendanceRow, byID map[int64]*models.Attendee, byEmail map[string]*models.Attendee) (*models.Attendee, string) {
+	if row.problem != "" {
+		return nil, row.problem
+	}
+
+	var attendee *models.Attendee
+	switch {
+	case row.VolunteerID != 0:
+		attendee = byID[row.VolunteerID]
+	case row.Email != "":
+		attendee = byEmail[strings.ToLower(strings.TrimSpace(row.Email))]
+	default:
+		return nil, "volunteer_id or email is required"
+	}
+	if attendee == nil {
+		return nil, "volunteer is not registered for this event"
+	}
+	return attendee, ""
+}
+
+// readSignInSheet parses a CSV sign-in sheet into rows numbered by line.
+// Problems with a single row are kept on the row for the report.
+func readSignInSheet(sheet io.Reader) ([]*attendanceRow, error) {
+	reader := csv.NewReader(sheet)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, ErrInvalidSheet
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	_, hasEmail := columns["email"]
+	_, hasID := columns["volunteer_id"]
+	if !hasEmail && !hasID {
+		return nil, ErrInvalidSheet
+	}
+
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var rows []*attendanceRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, err
+			}
+			rows = append(rows, &attendanceRow{row: parseErr.Line, problem: "could not read row: " + parseErr.Err.Error()})
+			continue
+		}
+		if len(rows) == maxAttendanceRows {
+			return nil, ErrSheetTooLong
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := &attendanceRow{row: line}
+		row.Email = field(record, "email")
+		row.Status = strings.ToLower(field(record, "status"))
+		if row.Status == "" {
+			row.Status = models.RegistrationStatusAttended
+		}
+		if row.Status != models.RegistrationStatusAttended && row.Status != models.RegistrationStatusNoShow {
+			row.problem = "status must be attended or no-show"
+		}
+		if value := field(record, "volunteer_id"); value != "" {
+			id, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || id <= 0 {
+				row.problem = "volunteer_id must be a number"
+			}
+			row.VolunteerID = id
+		}
+		if value := field(record, "hours"); value != "" {
+			hours, err := strconv.ParseFloat(value, 64)
+			if err != nil || math.IsNaN(hours) || math.IsInf(hours, 0) || hours < 0 || hours > 24 {
+				row.problem = "hours must be a number between 0 and 24"
+			}
+			row.Hours = &hours
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// eventLengthHours is the default credit for attending: the time between the
+// event's start and end, rounded to the nearest quarter hour.
+func eventLengthHours(event *models.Event) float64 {
+	hours := event.EndTime.Sub(event.StartTime).Hours()
+	if hours <= 0 {
+		return 0
+	}
+	return math.Min(math.Round(hours*4)/4, 24)
+}
@@ -106,7 +106,7 @@ type RegisterInput struct {
 	Name     string      `json:"name" binding:"required"`
 	Email    string      `json:"email" binding:"required,email"`
 	Password string      `json:"password" binding:"required,min=6"`
-	Role     models.Role `json:"role" binding:"required,oneof=admin volunteer"`
+	Role     models.Role `json:"role" binding:"required,oneof=admin volunteer school_admin"`
 }
 
 type LoginInput struct {
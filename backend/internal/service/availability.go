@@ -0,0 +1,325 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+)
+
+var (
+	ErrAvailabilityWindowNotFound   = errors.New("availability window not found")
+	ErrAvailabilityBlackoutNotFound = errors.New("blackout not found")
+	ErrInvalidAvailabilityWindow    = errors.New("times must be HH:MM between 00:00 and 24:00, with the start before the end")
+	ErrAvailabilityWindowOverlap    = errors.New("this window overlaps another window on the same day")
+	ErrInvalidBlackout              = errors.New("blackout dates must be YYYY-MM-DD, with the start on or before the end")
+	ErrInvalidShift                 = errors.New("a shift needs a start and an end at most 24 hours later")
+)
+
+// maxShiftLength bounds the shifts availability can be checked for; longer
+// ones would span more than one pair of days.
+const maxShiftLength = 24 * time.Hour
+
+type AvailabilityService struct {
+	availabilityRepo repository.AvailabilityRepository
+	volunteerRepo    VolunteerRepository
+	eventService     *EventService
+	cacheService     *CacheService
+}
+
+func NewAvailabilityService(
+	availabilityRepo repository.AvailabilityRepository,
+	volunteerRepo VolunteerRepository,
+	eventService *EventService,
+	cacheService *CacheService) *AvailabilityService {
+	return &AvailabilityService{
+		availabilityRepo: availabilityRepo,
+		volunteerRepo:    volunteerRepo,
+		eventService:     eventService,
+		cacheService:     cacheService,
+	}
+}
+
+func (s *AvailabilityService) GetMine(ctx context.Context, userID int64) (*models.VolunteerAvailability, error) {
+	volunteer, err := s.currentVolunteer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.get(ctx, volunteer)
+}
+
+// GetForVolunteer returns a volunteer's availability to an admin or
+// organization.
+func (s *AvailabilityService) GetForVolunteer(ctx context.Context, volunteerID int64) (*models.VolunteerAvailability, error) {
+	volunteer, err := s.volunteerRepo.GetByID(ctx, volunteerID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+	return s.get(ctx, volunteer)
+}
+
+func (s *AvailabilityService) get(ctx context.Context, volunteer *models.Volunteer) (*models.VolunteerAvailability, error) {
+	windows, err := s.availabilityRepo.ListWindows(ctx, volunteer.ID)
+	if err != nil {
+		return nil, err
+	}
+	blackouts, err := s.availabilityRepo.ListBlackouts(ctx, volunteer.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	timeZone := volunteer.TimeZone
+	if timeZone == "" {
+		timeZone = models.DefaultVolunteerTimeZone
+	}
+	return &models.VolunteerAvailability{
+		VolunteerID: volunteer.ID,
+		TimeZone:    timeZone,
+		Note:        volunteer.Availability,
+		Windows:     windows,
+		Blackouts:   blackouts,
+	}, nil
+}
+
+// SetTimeZone changes the time zone the volunteer's windows are read in. The
+// windows keep their wall clock times.
+func (s *AvailabilityService) SetTimeZone(ctx context.Context, input *models.SetAvailabilityTimeZoneInput, userID int64) (*models.VolunteerAvailability, error) {
+	volunteer, err := s.currentVolunteer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := time.LoadLocation(input.TimeZone); err != nil {
+		return nil, ErrInvalidTimeZone
+	}
+
+	if err := s.availabilityRepo.SetTimeZone(ctx, volunteer.ID, input.TimeZone); err != nil {
+		return nil, err
+	}
+	if err := s.cacheService.DeleteVolunteer(ctx, volunteer.ID); err != nil {
+		// logger.Error("Failed to invalidate volunteer cache", err)
+	}
+
+	volunteer.TimeZone = input.TimeZone
+	return s.get(ctx, volunteer)
+}
+
+func (s *AvailabilityService) AddWindow(ctx context.Context, input *models.AvailabilityWindowInput, userID int64) (*models.AvailabilityWindow, error) {
+	volunteer, err := s.currentVolunteer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	window := &models.AvailabilityWindow{VolunteerID: volunteer.ID}
+	if err := s.applyWindow(ctx, window, input); err != nil {
+		return nil, err
+	}
+	if err := s.availabilityRepo.CreateWindow(ctx, window); err != nil {
+		return nil, err
+	}
+	return window, nil
+}
+
+func (s *AvailabilityService) UpdateWindow(ctx context.Context, id int64, input *models.AvailabilityWindowInput, userID int64) (*models.AvailabilityWindow, error) {
+	window, err := s.ownWindow(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.applyWindow(ctx, window, input); err != nil {
+		return nil, err
+	}
+	if err := s.availabilityRepo.UpdateWindow(ctx, window); err != nil {
+		return nil, err
+	}
+	return window, nil
+}
+
+func (s *AvailabilityService) DeleteWindow(ctx context.Context, id int64, userID int64) error {
+	if _, err := s.ownWindow(ctx, id, userID); err != nil {
+		return err
+	}
+	return s.availabilityRepo.DeleteWindow(ctx, id)
+}
+
+// applyWindow validates the input onto the window, refusing overlaps with the
+// volunteer's other windows that day.
+func (s *AvailabilityService) applyWindow(ctx context.Context, window *models.AvailabilityWindow, input *models.AvailabilityWindowInput) error {
+	start, ok := parseClock(input.StartTime)
+	if !ok {
+		return ErrInvalidAvailabilityWindow
+	}
+	end, ok := parseClock(input.EndTime)
+	if !ok || start >= end || start == "24:00" {
+		return ErrInvalidAvailabilityWindow
+	}
+
+	windows, err := s.availabilityRepo.ListWindows(ctx, window.VolunteerID)
+	if err != nil {
+		return err
+	}
+	for _, other := range windows {
+		if other.ID != window.ID && other.Weekday == *input.Weekday && start < other.EndTime && other.StartTime < end {
+			return ErrAvailabilityWindowOverlap
+		}
+	}
+
+	window.Weekday = *input.Weekday
+	window.StartTime = start
+	window.EndTime = end
+	return nil
+}
+
+func (s *AvailabilityService) AddBlackout(ctx context.Context, input *models.AvailabilityBlackoutInput, userID int64) (*models.AvailabilityBlackout, error) {
+	volunteer, err := s.currentVolunteer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	blackout := &models.AvailabilityBlackout{VolunteerID: volunteer.ID}
+	if err := applyBlackout(blackout, input); err != nil {
+		return nil, err
+	}
+	if err := s.availabilityRepo.CreateBlackout(ctx, blackout); err != nil {
+		return nil, err
+	}
+	return blackout, nil
+}
+
+func (s *AvailabilityService) UpdateBlackout(ctx context.Context, id int64, input *models.AvailabilityBlackoutInput, userID int64) (*models.AvailabilityBlackout, error) {
+	blackout, err := s.ownBlackout(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyBlackout(blackout, input); err != nil {
+		return nil, err
+	}
+	if err := s.availabilityRepo.UpdateBlackout(ctx, blackout); err != nil {
+		return nil, err
+	}
+	return blackout, nil
+}
+
+func (s *AvailabilityService) DeleteBlackout(ctx context.Context, id int64, userID int64) error {
+	if _, err := s.ownBlackout(ctx, id, userID); err != nil {
+		return err
+	}
+	return s.availabilityRepo.DeleteBlackout(ctx, id)
+}
+
+func applyBlackout(blackout *models.AvailabilityBlackout, input *models.AvailabilityBlackoutInput) error {
+	start, err := time.Parse("2006-01-02", input.StartDate)
+	if err != nil {
+		return ErrInvalidBlackout
+	}
+	end, err := time.Parse("2006-01-02", input.EndDate)
+	if err != nil || end.Before(start) {
+		return ErrInvalidBlackout
+	}
+
+	blackout.StartDate = start
+	blackout.EndDate = end
+	blackout.Reason = strings.TrimSpace(input.Reason)
+	return nil
+}
+
+// ListAvailable finds volunteers free for the whole of a shift, optionally
+// with any of the given skills or near a location.
+func (s *AvailabilityService) ListAvailable(ctx context.Context, start, end time.Time, skills []string, location string, offset, limit int) ([]*models.Volunteer, error) {
+	return s.listAvailable(ctx, &repository.AvailabilityParams{
+		Start:    start,
+		End:      end,
+		Skills:   skills,
+		Location: location,
+		Offset:   offset,
+		Limit:    limit,
+	})
+}
+
+// ListAvailableForEvent finds volunteers free for an event hosted by the
+// caller's organization who have not registered for it yet.
+func (s *AvailabilityService) ListAvailableForEvent(ctx context.Context, eventID int64, skills []string, offset, limit int, userID int64) ([]*models.Volunteer, error) {
+	event, err := s.eventService.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.eventService.checkEventEditor(ctx, event, userID); err != nil {
+		return nil, err
+	}
+
+	return s.listAvailable(ctx, &repository.AvailabilityParams{
+		Start:          event.StartTime,
+		End:            event.EndTime,
+		Skills:         skills,
+		ExcludeEventID: event.ID,
+		Offset:         offset,
+		Limit:          limit,
+	})
+}
+
+func (s *AvailabilityService) listAvailable(ctx context.Context, params *repository.AvailabilityParams) ([]*models.Volunteer, error) {
+	if params.Start.IsZero() || !params.End.After(params.Start) || params.End.Sub(params.Start) > maxShiftLength {
+		return nil, ErrInvalidShift
+	}
+	return s.availabilityRepo.ListAvailableVolunteers(ctx, params)
+}
+
+func (s *AvailabilityService) ownWindow(ctx context.Context, id, userID int64) (*models.AvailabilityWindow, error) {
+	volunteer, err := s.currentVolunteer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	window, err := s.availabilityRepo.GetWindow(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if window == nil || window.VolunteerID != volunteer.ID {
+		return nil, ErrAvailabilityWindowNotFound
+	}
+	return window, nil
+}
+
+func (s *AvailabilityService) ownBlackout(ctx context.Context, id, userID int64) (*models.AvailabilityBlackout, error) {
+	volunteer, err := s.currentVolunteer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	blackout, err := s.availabilityRepo.GetBlackout(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if blackout == nil || blackout.VolunteerID != volunteer.ID {
+		return nil, ErrAvailabilityBlackoutNotFound
+	}
+	return blackout, nil
+}
+
+func (s *AvailabilityService) currentVolunteer(ctx context.Context, userID int64) (*models.Volunteer, error) {
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+	return volunteer, nil
+}
+
+// parseClock normalizes an "H:MM" or "HH:MM" time of day, allowing "24:00"
+// for midnight at the end of the day.
+func parseClock(value string) (string, bool) {
+	var hour, minute int
+	if _, err := fmt.Sscanf(strings.TrimSpace(value), "%d:%d", &hour, &minute); err != nil {
+		return "", false
+	}
+	if hour < 0 || hour > 24 || minute < 0 || minute > 59 || (hour == 24 && minute != 0) {
+		return "", false
+	}
+	return fmt.Sprintf("%02d:%02d", hour, minute), true
+}
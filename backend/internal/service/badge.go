@@ -0,0 +1,335 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+)
+
+var ErrBadgeNotFound = errors.New("badge not found")
+
+// backfillPageSize is how many volunteers Backfill loads at a time.
+const backfillPageSize = 200
+
+type BadgeService struct {
+	badgeRepo           repository.BadgeRepository
+	volunteerRepo       VolunteerRepository
+	organizationRepo    repository.OrganizationRepository
+	notificationService *NotificationService
+}
+
+func NewBadgeService(
+	badgeRepo repository.BadgeRepository,
+	volunteerRepo VolunteerRepository,
+	organizationRepo repository.OrganizationRepository,
+	notificationService *NotificationService) *BadgeService {
+	return &BadgeService{
+		badgeRepo:           badgeRepo,
+		volunteerRepo:       volunteerRepo,
+		organizationRepo:    organizationRepo,
+		notificationService: notificationService,
+	}
+}
+
+func (s *BadgeService) List(ctx context.Context, activeOnly bool) ([]*models.Badge, error) {
+	return s.badgeRepo.List(ctx, activeOnly)
+}
+
+// Create defines a new badge. Volunteers who already meet it are awarded it
+// the next time their hours or attendance change, or by a backfill.
+func (s *BadgeService) Create(ctx context.Context, input *models.BadgeInput, adminID int64) (*models.Badge, error) {
+	badge := &models.Badge{CreatedBy: adminID}
+	if err := s.apply(ctx, badge, input); err != nil {
+		return nil, err
+	}
+	if err := s.badgeRepo.Create(ctx, badge); err != nil {
+		return nil, err
+	}
+	return badge, nil
+}
+
+// Update changes a badge's rule. Achievements already awarded are kept.
+func (s *BadgeService) Update(ctx context.Context, id int64, input *models.BadgeInput) (*models.Badge, error) {
+	badge, err := s.badgeRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if badge == nil {
+		return nil, ErrBadgeNotFound
+	}
+
+	if err := s.apply(ctx, badge, input); err != nil {
+		return nil, err
+	}
+	if err := s.badgeRepo.Update(ctx, badge); err != nil {
+		return nil, err
+	}
+	return badge, nil
+}
+
+func (s *BadgeService) Delete(ctx context.Context, id int64) error {
+	badge, err := s.badgeRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if badge == nil {
+		return ErrBadgeNotFound
+	}
+	return s.badgeRepo.Delete(ctx, id)
+}
+
+// apply validates the input onto the badge; each rule type needs its own
+// parameters.
+func (s *BadgeService) apply(ctx context.Context, badge *models.Badge, input *models.BadgeInput) error {
+	problems := make(map[string]string)
+	category := strings.TrimSpace(input.Category)
+	switch input.RuleType {
+	case models.BadgeRuleTotalHours:
+		if input.Threshold <= 0 {
+			problems["threshold"] = "must be the number of hours to reach"
+		}
+	case models.BadgeRuleCategoryEvents:
+		if input.Threshold < 1 || input.Threshold != math.Trunc(input.Threshold) {
+			problems["threshold"] = "must be a whole number of events"
+		}
+		if category == "" {
+			problems["category"] = "is required for a category badge"
+		}
+	case models.BadgeRuleConsecutiveMonths:
+		if input.Threshold < 1 || input.Threshold != math.Trunc(input.Threshold) {
+			problems["threshold"] = "must be a whole number of months"
+		}
+	case models.BadgeRuleNewOrganization:
+		if input.OrganizationID != nil {
+			org, err := s.organizationRepo.GetByID(ctx, *input.OrganizationID)
+			if err != nil {
+				return err
+			}
+			if org == nil {
+				problems["organization_id"] = "does not exist"
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return &FormValidationError{Fields: problems}
+	}
+
+	badge.Name = strings.TrimSpace(input.Name)
+	badge.Description = strings.TrimSpace(input.Description)
+	badge.Icon = strings.TrimSpace(input.Icon)
+	badge.RuleType = input.RuleType
+	badge.Threshold = input.Threshold
+	badge.Category = ""
+	badge.OrganizationID = nil
+	switch input.RuleType {
+	case models.BadgeRuleCategoryEvents:
+		badge.Category = category
+	case models.BadgeRuleNewOrganization:
+		badge.OrganizationID = input.OrganizationID
+	}
+	badge.Active = input.Active == nil || *input.Active
+	return nil
+}
+
+func (s *BadgeService) ListAchievements(ctx context.Context, volunteerID int64) ([]*models.Achievement, error) {
+	volunteer, err := s.volunteerRepo.GetByID(ctx, volunteerID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+	return s.volunteerRepo.ListAchievements(ctx, volunteerID)
+}
+
+// GetMyProgress shows the volunteer each active badge with what they have
+// done toward it and the achievements it already gave them.
+func (s *BadgeService) GetMyProgress(ctx context.Context, userID int64) ([]*models.BadgeProgress, error) {
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+
+	badges, err := s.badgeRepo.List(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := s.badgeRepo.GetStats(ctx, volunteer.ID)
+	if err != nil {
+		return nil, err
+	}
+	achievements, err := s.volunteerRepo.ListAchievements(ctx, volunteer.ID)
+	if err != nil {
+		return nil, err
+	}
+	earned := make(map[int64][]*models.Achievement)
+	for _, achievement := range achievements {
+		if achievement.BadgeID != nil {
+			earned[*achievement.BadgeID] = append(earned[*achievement.BadgeID], achievement)
+		}
+	}
+
+	progress := make([]*models.BadgeProgress, len(badges))
+	for i, badge := range badges {
+		current, target := badgeProgress(badge, stats)
+		progress[i] = &models.BadgeProgress{
+			Badge:    badge,
+			Current:  current,
+			Target:   target,
+			Earned:   earned[badge.ID],
+			Complete: len(earned[badge.ID]) > 0,
+		}
+	}
+	return progress, nil
+}
+
+// Evaluate awards the volunteer every active badge they now meet and notifies
+// them of each new one. Badges already held are not awarded again.
+func (s *BadgeService) Evaluate(ctx context.Context, volunteerID int64) ([]*models.Achievement, error) {
+	return s.evaluate(ctx, volunteerID, true)
+}
+
+// Backfill evaluates every volunteer's history, for badges defined after the
+// fact. Backfilled badges are awarded without notifications. It returns how
+// many achievements were awarded.
+func (s *BadgeService) Backfill(ctx context.Context) (int, error) {
+	awarded := 0
+	for offset := 0; ; offset += backfillPageSize {
+		volunteers, err := s.volunteerRepo.List(ctx, offset, backfillPageSize)
+		if err != nil {
+			return awarded, err
+		}
+		for _, volunteer := range volunteers {
+			achievements, err := s.evaluate(ctx, volunteer.ID, false)
+			if err != nil {
+				return awarded, fmt.Errorf("volunteer %d: %w", volunteer.ID, err)
+			}
+			awarded += len(achievements)
+		}
+		if len(volunteers) < backfillPageSize {
+			return awarded, nil
+		}
+	}
+}
+
+func (s *BadgeService) evaluate(ctx context.Context, volunteerID int64, notify bool) ([]*models.Achievement, error) {
+	badges, err := s.badgeRepo.List(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(badges) == 0 {
+		return nil, nil
+	}
+	stats, err := s.badgeRepo.GetStats(ctx, volunteerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var awarded []*models.Achievement
+	for _, badge := range badges {
+		for _, achievement := range badgeAwards(badge, stats, volunteerID) {
+			created, err := s.badgeRepo.Award(ctx, achievement)
+			if err != nil {
+				return awarded, err
+			}
+			if !created {
+				continue
+			}
+			awarded = append(awarded, achievement)
+
+			if !notify {
+				continue
+			}
+			notification := &models.CreateNotificationInput{
+				Type:    models.NotificationBadgeAwarded,
+				Title:   fmt.Sprintf("You earned the %s badge", achievement.Name),
+				Message: achievement.Description,
+			}
+			if _, err := s.notificationService.NotifyVolunteer(ctx, volunteerID, notification); err != nil {
+				// Log error but don't fail the operation
+				// logger.Error("Failed to notify volunteer of badge", err)
+			}
+		}
+	}
+	return awarded, nil
+}
+
+// badgeAwards returns the achievements the stats qualify for under a badge,
+// whether or not the volunteer already holds them.
+func badgeAwards(badge *models.Badge, stats *models.BadgeStats, volunteerID int64) []*models.Achievement {
+	award := func(organizationID *int64, description string, awardedDate time.Time) *models.Achievement {
+		return &models.Achievement{
+			VolunteerID:    volunteerID,
+			BadgeID:        &badge.ID,
+			OrganizationID: organizationID,
+			Name:           badge.Name,
+			Description:    description,
+			Icon:           badge.Icon,
+			AwardedDate:    awardedDate,
+		}
+	}
+
+	if badge.RuleType == models.BadgeRuleNewOrganization {
+		var achievements []*models.Achievement
+		for _, org := range stats.Organizations {
+			if badge.OrganizationID != nil && *badge.OrganizationID != org.ID {
+				continue
+			}
+			orgID := org.ID
+			achievements = append(achievements, award(&orgID, fmt.Sprintf("%s (%s)", badge.Description, org.Name), org.FirstAttended))
+		}
+		return achievements
+	}
+
+	if current, target := badgeProgress(badge, stats); current >= target {
+		return []*models.Achievement{award(nil, badge.Description, time.Now())}
+	}
+	return nil
+}
+
+// badgeProgress measures the stats against a badge's threshold. A badge
+// awarded per organization counts the organizations attended so far.
+func badgeProgress(badge *models.Badge, stats *models.BadgeStats) (float64, float64) {
+	target := math.Max(badge.Threshold, 1)
+	switch badge.RuleType {
+	case models.BadgeRuleTotalHours:
+		return stats.TotalHours, badge.Threshold
+	case models.BadgeRuleCategoryEvents:
+		return float64(stats.EventsByCategory[strings.ToLower(badge.Category)]), target
+	case models.BadgeRuleConsecutiveMonths:
+		return float64(longestMonthStreak(stats.ActiveMonths)), target
+	case models.BadgeRuleNewOrganization:
+		count := 0
+		for _, org := range stats.Organizations {
+			if badge.OrganizationID == nil || *badge.OrganizationID == org.ID {
+				count++
+			}
+		}
+		return float64(count), 1
+	}
+	return 0, target
+}
+
+// longestMonthStreak counts the longest run of calendar months in a row among
+// the given months, which are in ascending order.
+func longestMonthStreak(months []time.Time) int {
+	longest, run := 0, 0
+	for i, month := range months {
+		run++
+		if i > 0 && month.Year()*12+int(month.Month()) != months[i-1].Year()*12+int(months[i-1].Month())+1 {
+			run = 1
+		}
+		if run > longest {
+			longest = run
+		}
+	}
+	return longest
+}
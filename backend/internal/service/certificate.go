@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"volunteer-management/internal/config"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+	"volunteer-management/pkg/pdf"
+)
+
+var (
+	ErrIssuedDocumentNotFound = errors.New("no document was issued with this verification code")
+	ErrNoApprovedHours        = errors.New("volunteer has no approved hours to certify")
+)
+
+type CertificateService struct {
+	certificateRepo repository.CertificateRepository
+	volunteerRepo   VolunteerRepository
+	userRepo        repository.UserRepository
+	eventService    *EventService
+	cfg             config.CertificateConfig
+}
+
+func NewCertificateService(
+	certificateRepo repository.CertificateRepository,
+	volunteerRepo VolunteerRepository,
+	userRepo repository.UserRepository,
+	eventService *EventService,
+	cfg config.CertificateConfig) *CertificateService {
+	return &CertificateService{
+		certificateRepo: certificateRepo,
+		volunteerRepo:   volunteerRepo,
+		userRepo:        userRepo,
+		eventService:    eventService,
+		cfg:             cfg,
+	}
+}
+
+// Transcript issues the volunteer a signed transcript of every event they
+// have approved hours for.
+func (s *CertificateService) Transcript(ctx context.Context, userID int64) ([]byte, *models.IssuedDocument, error) {
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if volunteer == nil {
+		return nil, nil, ErrVolunteerNotFound
+	}
+
+	entries, err := s.certificateRepo.ListApprovedHours(ctx, volunteer.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(entries) == 0 {
+		return nil, nil, ErrNoApprovedHours
+	}
+
+	document := &models.IssuedDocument{
+		Kind:        models.IssuedDocumentTranscript,
+		VolunteerID: volunteer.ID,
+		IssuedBy:    userID,
+	}
+	return s.issue(ctx, document, volunteer, entries)
+}
+
+// Certificate issues a certificate for the hours a volunteer served at one of
+// the organization's events.
+func (s *CertificateService) Certificate(ctx context.Context, eventID, volunteerID, userID int64) ([]byte, *models.IssuedDocument, error) {
+	event, err := s.eventService.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+	org, err := s.eventService.checkEventEditor(ctx, event, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	volunteer, err := s.volunteerRepo.GetByID(ctx, volunteerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if volunteer == nil {
+		return nil, nil, ErrVolunteerNotFound
+	}
+
+	entry, err := s.certificateRepo.GetApprovedHours(ctx, eventID, volunteerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if entry == nil {
+		return nil, nil, ErrNoApprovedHours
+	}
+
+	document := &models.IssuedDocument{
+		Kind:           models.IssuedDocumentCertificate,
+		VolunteerID:    volunteer.ID,
+		EventID:        &event.ID,
+		OrganizationID: &org.ID,
+		IssuedBy:       userID,
+	}
+	return s.issue(ctx, document, volunteer, []*models.ServiceHoursEntry{entry})
+}
+
+// Verify looks up an issued document by its verification code and checks it
+// still matches its signature. It needs no login.
+func (s *CertificateService) Verify(ctx context.Context, code string) (*models.DocumentVerification, error) {
+	document, err := s.certificateRepo.GetByCode(ctx, normalizeVerificationCode(code))
+	if err != nil {
+		return nil, err
+	}
+	if document == nil {
+		return nil, ErrIssuedDocumentNotFound
+	}
+
+	signature, err := s.sign(document)
+	if err != nil {
+		return nil, err
+	}
+	return &models.DocumentVerification{
+		Valid:         hmac.Equal([]byte(signature), []byte(document.Signature)),
+		Code:          document.Code,
+		Kind:          document.Kind,
+		VolunteerName: document.VolunteerName,
+		TotalHours:    document.TotalHours,
+		Entries:       document.Entries,
+		ContentHash:   document.ContentHash,
+		IssuedAt:      document.IssuedAt,
+	}, nil
+}
+
+// issue fills in and signs the document, renders it and records it under a
+// new verification code.
+func (s *CertificateService) issue(ctx context.Context, document *models.IssuedDocument, volunteer *models.Volunteer, entries []*models.ServiceHoursEntry) ([]byte, *models.IssuedDocument, error) {
+	user, err := s.userRepo.GetByID(ctx, volunteer.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if user == nil {
+		return nil, nil, ErrUserNotFound
+	}
+
+	code, err := newVerificationCode()
+	if err != nil {
+		return nil, nil, err
+	}
+	document.Code = code
+	document.VolunteerName = user.Name
+	document.IssuedAt = time.Now().UTC().Truncate(time.Second)
+	for _, entry := range entries {
+		document.Entries = append(document.Entries, *entry)
+		document.TotalHours += entry.Hours
+	}
+	if document.Signature, err = s.sign(document); err != nil {
+		return nil, nil, err
+	}
+
+	content := s.render(document)
+	hash := sha256.Sum256(content)
+	document.ContentHash = hex.EncodeToString(hash[:])
+
+	if err := s.certificateRepo.Create(ctx, document); err != nil {
+		return nil, nil, err
+	}
+	return content, document, nil
+}
+
+func (s *CertificateService) render(document *models.IssuedDocument) []byte {
+	doc := pdf.New()
+	if document.Kind == models.IssuedDocumentCertificate {
+		entry := document.Entries[0]
+		doc.Heading("Certificate of Volunteer Service")
+		doc.Blank()
+		doc.Text(fmt.Sprintf("This certifies that %s served %s hours as a volunteer at %s, organized by %s on %s.",
+			document.VolunteerName, formatHours(entry.Hours), entry.EventTitle, entry.OrganizationName, entry.Date.Format("January 2, 2006")))
+		doc.Blank()
+		if entry.ApprovedBy != "" {
+			doc.Field("Hours approved by", entry.ApprovedBy)
+		}
+	} else {
+		doc.Heading("Volunteer Service Transcript")
+		doc.Field("Volunteer", document.VolunteerName)
+		doc.Field("Total approved hours", formatHours(document.TotalHours))
+		doc.Field("Events", fmt.Sprintf("%d", len(document.Entries)))
+		for _, entry := range document.Entries {
+			doc.Blank()
+			doc.Field(entry.Date.Format("2006-01-02"), entry.EventTitle)
+			doc.Text(fmt.Sprintf("Organization: %s", entry.OrganizationName))
+			doc.Text(fmt.Sprintf("Approved hours: %s", formatHours(entry.Hours)))
+			if entry.ApprovedBy != "" {
+				doc.Text(fmt.Sprintf("Approved by: %s", entry.ApprovedBy))
+			}
+		}
+	}
+
+	doc.Blank()
+	doc.Heading("Verification")
+	doc.Field("Issued", document.IssuedAt.Format(time.RFC1123))
+	doc.Field("Verification code", document.Code)
+	doc.Field("Verify at", strings.TrimRight(s.cfg.VerifyURL, "/")+"/"+document.Code)
+	doc.Field("Signature", document.Signature)
+	return doc.Bytes()
+}
+
+// sign computes the HMAC of the document's recorded contents.
+func (s *CertificateService) sign(document *models.IssuedDocument) (string, error) {
+	payload, err := json.Marshal(struct {
+		Code           string
+		Kind           models.IssuedDocumentKind
+		VolunteerID    int64
+		VolunteerName  string
+		EventID        *int64
+		OrganizationID *int64
+		TotalHours     float64
+		Entries        []models.ServiceHoursEntry
+		IssuedAt       int64
+	}{
+		document.Code, document.Kind, document.VolunteerID, document.VolunteerName,
+		document.EventID, document.OrganizationID, document.TotalHours, document.Entries,
+		document.IssuedAt.Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, []byte(s.cfg.SigningSecret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// newVerificationCode returns a random code such as "K7QM-2XHD-9PLA-R4TE"
+// that is easy to read out and type.
+func newVerificationCode() (string, error) {
+	random := make([]byte, 10)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	code := base32.StdEncoding.EncodeToString(random)
+	return code[0:4] + "-" + code[4:8] + "-" + code[8:12] + "-" + code[12:16], nil
+}
+
+// normalizeVerificationCode accepts codes typed in lower case or without
+// dashes.
+func normalizeVerificationCode(code string) string {
+	code = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	if len(code) != 16 {
+		return code
+	}
+	return code[0:4] + "-" + code[4:8] + "-" + code[8:12] + "-" + code[12:16]
+}
+
+func formatHours(hours float64) string {
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.2f", hours), "0"), ".")
+}
@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"volunteer-management/internal/config"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+	"volunteer-management/pkg/envelope"
+)
+
+var (
+	ErrEmergencyInfoUnavailable = errors.New("emergency info cannot be stored until an encryption key is configured")
+	ErrEmergencyInfoClosed      = errors.New("emergency info is only available for active events, shortly before and after they run")
+)
+
+type EmergencyInfoService struct {
+	emergencyRepo repository.EmergencyInfoRepository
+	volunteerRepo VolunteerRepository
+	eventRegRepo  repository.EventRegistrationRepository
+	eventService  *EventService
+	sealer        *envelope.Sealer // nil without a configured master key
+	cfg           config.EmergencyInfoConfig
+}
+
+func NewEmergencyInfoService(
+	emergencyRepo repository.EmergencyInfoRepository,
+	volunteerRepo VolunteerRepository,
+	eventRegRepo repository.EventRegistrationRepository,
+	eventService *EventService,
+	sealer *envelope.Sealer,
+	cfg config.EmergencyInfoConfig) *EmergencyInfoService {
+	return &EmergencyInfoService{
+		emergencyRepo: emergencyRepo,
+		volunteerRepo: volunteerRepo,
+		eventRegRepo:  eventRegRepo,
+		eventService:  eventService,
+		sealer:        sealer,
+		cfg:           cfg,
+	}
+}
+
+// GetMine returns the volunteer's own emergency info, empty when they have
+// not given any.
+func (s *EmergencyInfoService) GetMine(ctx context.Context, userID int64) (*models.EmergencyInfo, error) {
+	volunteer, err := s.volunteer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	record, err := s.emergencyRepo.Get(ctx, volunteer.ID)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return &models.EmergencyInfo{}, nil
+	}
+	return s.open(record)
+}
+
+// SaveMine encrypts and stores the volunteer's emergency info. Saving it with
+// every field empty removes it.
+func (s *EmergencyInfoService) SaveMine(ctx context.Context, userID int64, input *models.EmergencyInfo) (*models.EmergencyInfo, error) {
+	if s.sealer == nil {
+		return nil, ErrEmergencyInfoUnavailable
+	}
+	volunteer, err := s.volunteer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &models.EmergencyInfo{
+		ContactName:         strings.TrimSpace(input.ContactName),
+		ContactPhone:        strings.TrimSpace(input.ContactPhone),
+		ContactRelationship: strings.TrimSpace(input.ContactRelationship),
+		MedicalNotes:        strings.TrimSpace(input.MedicalNotes),
+	}
+	if *info == (models.EmergencyInfo{}) {
+		return info, s.emergencyRepo.Delete(ctx, volunteer.ID)
+	}
+
+	plaintext, err := json.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := s.sealer.Seal(plaintext, emergencyInfoOwner(volunteer.ID))
+	if err != nil {
+		return nil, err
+	}
+	record := &models.EmergencyInfoRecord{
+		VolunteerID: volunteer.ID,
+		KeyID:       sealed.KeyID,
+		WrappedKey:  sealed.WrappedKey,
+		Ciphertext:  sealed.Ciphertext,
+	}
+	if err := s.emergencyRepo.Save(ctx, record); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func (s *EmergencyInfoService) DeleteMine(ctx context.Context, userID int64) error {
+	volunteer, err := s.volunteer(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return s.emergencyRepo.Delete(ctx, volunteer.ID)
+}
+
+// ListMyAccesses shows the volunteer every time a coordinator read their
+// emergency info.
+func (s *EmergencyInfoService) ListMyAccesses(ctx context.Context, userID int64, offset, limit int) ([]*models.EmergencyInfoAccess, error) {
+	volunteer, err := s.volunteer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.emergencyRepo.ListAccesses(ctx, volunteer.ID, offset, limit)
+}
+
+// ListForEvent returns the emergency info of every volunteer registered to
+// the coordinator's event.
+func (s *EmergencyInfoService) ListForEvent(ctx context.Context, eventID, userID int64, ipAddress string) ([]*models.VolunteerEmergencyInfo, error) {
+	event, org, err := s.checkAccess(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	attendees, err := s.eventRegRepo.ListAttendees(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+	return s.reveal(ctx, event, org, attendees, userID, ipAddress)
+}
+
+// GetForEventVolunteer returns the emergency info of one volunteer registered
+// to the coordinator's event.
+func (s *EmergencyInfoService) GetForEventVolunteer(ctx context.Context, eventID, volunteerID, userID int64, ipAddress string) (*models.VolunteerEmergencyInfo, error) {
+	event, org, err := s.checkAccess(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	attendees, err := s.eventRegRepo.ListAttendees(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, attendee := range attendees {
+		if attendee.VolunteerID == volunteerID {
+			infos, err := s.reveal(ctx, event, org, []*models.Attendee{attendee}, userID, ipAddress)
+			if err != nil {
+				return nil, err
+			}
+			return infos[0], nil
+		}
+	}
+	return nil, ErrRegistrationNotFound
+}
+
+// checkAccess allows the event's hosts to read emergency info while the
+// event is active and within the configured window around it.
+func (s *EmergencyInfoService) checkAccess(ctx context.Context, eventID, userID int64) (*models.Event, *models.Organization, error) {
+	if s.sealer == nil {
+		return nil, nil, ErrEmergencyInfoUnavailable
+	}
+	event, err := s.eventService.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+	org, err := s.eventService.checkEventEditor(ctx, event, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	if event.Status != models.EventStatusActive ||
+		now.Before(event.StartTime.Add(-s.cfg.AccessBefore)) ||
+		now.After(event.EndTime.Add(s.cfg.AccessAfter)) {
+		return nil, nil, ErrEmergencyInfoClosed
+	}
+	return event, org, nil
+}
+
+// reveal decrypts the attendees' emergency info. Every volunteer whose info
+// is shown is logged first, and nothing is shown if logging fails.
+func (s *EmergencyInfoService) reveal(ctx context.Context, event *models.Event, org *models.Organization, attendees []*models.Attendee, userID int64, ipAddress string) ([]*models.VolunteerEmergencyInfo, error) {
+	volunteerIDs := make([]int64, 0, len(attendees))
+	for _, attendee := range attendees {
+		volunteerIDs = append(volunteerIDs, attendee.VolunteerID)
+	}
+	records, err := s.emergencyRepo.ListByVolunteers(ctx, volunteerIDs)
+	if err != nil {
+		return nil, err
+	}
+	byVolunteer := make(map[int64]*models.EmergencyInfoRecord, len(records))
+	for _, record := range records {
+		byVolunteer[record.VolunteerID] = record
+	}
+
+	now := time.Now()
+	infos := make([]*models.VolunteerEmergencyInfo, 0, len(attendees))
+	var accesses []*models.EmergencyInfoAccess
+	for _, attendee := range attendees {
+		info := &models.VolunteerEmergencyInfo{VolunteerID: attendee.VolunteerID, Name: attendee.Name}
+		if record := byVolunteer[attendee.VolunteerID]; record != nil {
+			if info.Info, err = s.open(record); err != nil {
+				return nil, err
+			}
+			info.UpdatedAt = &record.UpdatedAt
+			accesses = append(accesses, &models.EmergencyInfoAccess{
+				VolunteerID:    attendee.VolunteerID,
+				EventID:        event.ID,
+				OrganizationID: org.ID,
+				AccessedBy:     userID,
+				IPAddress:      ipAddress,
+				AccessedAt:     now,
+			})
+		}
+		infos = append(infos, info)
+	}
+
+	if err := s.emergencyRepo.LogAccess(ctx, accesses); err != nil {
+		return nil, err
+	}
+	return infos, nil
+}
+
+func (s *EmergencyInfoService) open(record *models.EmergencyInfoRecord) (*models.EmergencyInfo, error) {
+	if s.sealer == nil {
+		return nil, ErrEmergencyInfoUnavailable
+	}
+	plaintext, err := s.sealer.Open(&envelope.Sealed{
+		KeyID:      record.KeyID,
+		WrappedKey: record.WrappedKey,
+		Ciphertext: record.Ciphertext,
+	}, emergencyInfoOwner(record.VolunteerID))
+	if err != nil {
+		return nil, err
+	}
+	var info models.EmergencyInfo
+	if err := json.Unmarshal(plaintext, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (s *EmergencyInfoService) volunteer(ctx context.Context, userID int64) (*models.Volunteer, error) {
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+	return volunteer, nil
+}
+
+// emergencyInfoOwner binds a sealed record to its volunteer, so a ciphertext
+// copied onto another volunteer's row does not decrypt.
+func emergencyInfoOwner(volunteerID int64) []byte {
+	return []byte("volunteer:" + strconv.FormatInt(volunteerID, 10))
+}
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 	"volunteer-management/internal/models"
 	"volunteer-management/internal/repository"
@@ -12,39 +14,74 @@ var (
 	ErrEventNotFound     = errors.New("event not found")
 	ErrEventCapacityFull = errors.New("event has reached maximum capacity")
 	ErrUnauthorized      = errors.New("user is not authorized to perform this action")
+
+	ErrEventHasRegistrations = errors.New("event has registrations; cancel it instead of deleting")
+	ErrEventAlreadyCancelled = errors.New("event is already cancelled")
+	ErrEventNotPendingReview = errors.New("event is not awaiting review")
+	ErrEventNotCompletable   = errors.New("only an active event can be completed")
+	ErrReviewReasonRequired  = errors.New("a reason is required to reject or request changes")
+	ErrInvalidTimeZone       = errors.New("time zone must be a valid IANA name such as America/New_York")
 )
 
 type EventService struct {
-	eventRepo        repository.EventRepository
-	organizationRepo repository.OrganizationRepository
-	volunteerRepo    repository.VolunteerRepository
-	eventRegRepo     repository.EventRegistrationRepository
+	eventRepo           repository.EventRepository
+	organizationRepo    repository.OrganizationRepository
+	volunteerRepo       repository.VolunteerRepository
+	eventRegRepo        repository.EventRegistrationRepository
+	cohostRepo          repository.EventCohostRepository
+	feedbackRepo        repository.FeedbackRepository
+	registrationService *RegistrationService
+	notificationService *NotificationService
 }
 
 func NewEventService(
 	eventRepo repository.EventRepository,
 	organizationRepo repository.OrganizationRepository,
 	volunteerRepo repository.VolunteerRepository,
-	eventRegRepo repository.EventRegistrationRepository) *EventService {
+	eventRegRepo repository.EventRegistrationRepository,
+	cohostRepo repository.EventCohostRepository,
+	feedbackRepo repository.FeedbackRepository,
+	registrationService *RegistrationService,
+	notificationService *NotificationService) *EventService {
 	return &EventService{
-		eventRepo:        eventRepo,
-		organizationRepo: organizationRepo,
-		volunteerRepo:    volunteerRepo,
-		eventRegRepo:     eventRegRepo,
+		eventRepo:           eventRepo,
+		organizationRepo:    organizationRepo,
+		volunteerRepo:       volunteerRepo,
+		eventRegRepo:        eventRegRepo,
+		cohostRepo:          cohostRepo,
+		feedbackRepo:        feedbackRepo,
+		registrationService: registrationService,
+		notificationService: notificationService,
 	}
 }
 
-func (s *EventService) Create(ctx context.Context, input *models.CreateEventInput, organizationID int64) (*models.Event, error) {
-	// Verify organization exists
-	org, err := s.organizationRepo.GetByID(ctx, organizationID)
+// Create adds an event hosted by the organization of the user.
+func (s *EventService) Create(ctx context.Context, input *models.CreateEventInput, userID int64) (*models.Event, error) {
+	org, err := s.organizationRepo.GetByUserID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 	if org == nil {
-		return nil, errors.New("organization not found")
+		return nil, ErrUnauthorized
+	}
+
+	if input.TimeZone == "" {
+		input.TimeZone = models.DefaultEventTimeZone
+	}
+	loc, err := time.LoadLocation(input.TimeZone)
+	if err != nil {
+		return nil, ErrInvalidTimeZone
+	}
+	input.StartTime = input.StartTime.UTC()
+	input.EndTime = input.EndTime.UTC()
+	input.Date = localMidnight(input.StartTime, loc)
+
+	// Events from organizations that are not trusted go through admin review
+	if input.Status == models.EventStatusActive && requiresReview(org) {
+		input.Status = models.EventStatusPendingReview
 	}
 
-	event, err := s.eventRepo.Create(ctx, input, organizationID)
+	event, err := s.eventRepo.Create(ctx, input, org.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -64,167 +101,435 @@ func (s *EventService) GetByID(ctx context.Context, id int64) (*models.Event, er
 	return event, nil
 }
 
-func (s *EventService) Update(ctx context.Context, id int64, input *models.UpdateEventInput, userID int64) (*models.Event, error) {
+// GetVisible returns the event to a user browsing events. Events that have
+// not passed review are only found by their organization and admins.
+func (s *EventService) GetVisible(ctx context.Context, id int64, userID int64, role string) (*models.Event, error) {
 	event, err := s.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if !event.InReview() || models.Role(role) == models.RoleAdmin {
+		return event, nil
+	}
 
-	// Get organization for the user
 	org, err := s.organizationRepo.GetByUserID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
-	if org == nil {
-		return nil, ErrUnauthorized
+	if org == nil || org.ID != event.OrganizationID {
+		return nil, ErrEventNotFound
 	}
 
-	// Check if user is authorized to update the event (belongs to their organization)
-	if event.OrganizationID != org.ID {
-		return nil, ErrUnauthorized
+	return event, nil
+}
+
+func (s *EventService) Update(ctx context.Context, id int64, input *models.UpdateEventInput, userID int64) (*models.Event, error) {
+	event, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if user is authorized to update the event (their organization hosts or co-hosts it)
+	org, err := s.checkEventEditor(ctx, event, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := normalizeEventTimes(event, input); err != nil {
+		return nil, err
+	}
+
+	// Only the primary organization changes the status, so publishing is
+	// reviewed against its trust rather than a co-host's
+	if input.Status != nil && *input.Status != event.Status {
+		if org, err = s.checkEventOwner(ctx, event, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Publishing a draft or resubmitting after review needs approval again
+	if input.Status != nil && *input.Status == models.EventStatusActive &&
+		event.Status != models.EventStatusActive && requiresReview(org) {
+		status := models.EventStatusPendingReview
+		input.Status = &status
+	}
+
+	// So does changing what a published event says about itself
+	if event.Status == models.EventStatusActive && (input.Status == nil || *input.Status == models.EventStatusActive) &&
+		changesContent(event, input) && requiresReview(org) {
+		status := models.EventStatusPendingReview
+		input.Status = &status
 	}
 
+	// Completing requests feedback and credits the event in impact reports,
+	// so only an event that actually ran can be completed
+	if input.Status != nil && *input.Status == models.EventStatusComplete &&
+		event.Status != models.EventStatusComplete && event.Status != models.EventStatusActive {
+		return nil, ErrEventNotCompletable
+	}
+
+	// Setting the status to cancelled goes through the cancellation cascade
+	if input.Status != nil && *input.Status == models.EventStatusCancelled && event.Status != models.EventStatusCancelled {
+		return s.cancel(ctx, id, "", input)
+	}
+
+	completing := input.Status != nil && *input.Status == models.EventStatusComplete && event.Status != models.EventStatusComplete
+
 	event, err = s.eventRepo.Update(ctx, id, input)
 	if err != nil {
 		return nil, err
 	}
 
+	if completing {
+		s.requestFeedback(ctx, event)
+	}
+
 	return event, nil
 }
 
+// requestFeedback asks every volunteer who took part in a completed event to
+// rate it.
+func (s *EventService) requestFeedback(ctx context.Context, event *models.Event) {
+	participants, err := s.feedbackRepo.ListParticipants(ctx, event.ID)
+	if err != nil {
+		// Log error but don't fail the operation
+		// logger.Error("Failed to list participants for feedback", err)
+		return
+	}
+
+	for _, participant := range participants {
+		notification := &models.CreateNotificationInput{
+			Type:    models.NotificationFeedbackRequested,
+			Title:   fmt.Sprintf("How was %s?", event.Title),
+			Message: "Rate the event and its organizer to help them improve.",
+			EventID: &event.ID,
+		}
+		if _, err := s.notificationService.NotifyVolunteer(ctx, participant.VolunteerID, notification); err != nil {
+			// logger.Error("Failed to request feedback", err)
+		}
+	}
+}
+
+// Cancel cancels the event, moves every open registration to the
+// cancelled-by-organizer state and notifies each registrant.
+func (s *EventService) Cancel(ctx context.Context, id int64, input *models.CancelEventInput, userID int64) (*models.Event, error) {
+	event, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.checkEventOwner(ctx, event, userID); err != nil {
+		return nil, err
+	}
+
+	if event.Status == models.EventStatusCancelled {
+		return nil, ErrEventAlreadyCancelled
+	}
+
+	return s.cancel(ctx, id, input.Message, nil)
+}
+
 func (s *EventService) Delete(ctx context.Context, id int64, userID int64) error {
 	event, err := s.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
 
+	// Check if user is authorized to delete the event (belongs to their organization)
+	if _, err := s.checkEventOwner(ctx, event, userID); err != nil {
+		return err
+	}
+
+	// Events that volunteers have registered for must be cancelled, not deleted
+	hasRegistrations, err := s.registrationService.HasRegistrations(ctx, id)
+	if err != nil {
+		return err
+	}
+	if hasRegistrations {
+		return ErrEventHasRegistrations
+	}
+
+	if err := s.eventRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// checkEventOwner verifies the event belongs to the organization of the user
+// and returns that organization.
+func (s *EventService) checkEventOwner(ctx context.Context, event *models.Event, userID int64) (*models.Organization, error) {
 	// Get organization for the user
 	org, err := s.organizationRepo.GetByUserID(ctx, userID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if org == nil {
-		return ErrUnauthorized
+		return nil, ErrUnauthorized
 	}
 
-	// Check if user is authorized to delete the event (belongs to their organization)
 	if event.OrganizationID != org.ID {
-		return ErrUnauthorized
+		return nil, ErrUnauthorized
 	}
 
-	if err := s.eventRepo.Delete(ctx, id); err != nil {
-		return err
+	return org, nil
+}
+
+// normalizeEventTimes validates a time zone change, stores the new times in UTC
+// and keeps Date on the local day the event starts.
+func normalizeEventTimes(event *models.Event, input *models.UpdateEventInput) error {
+	if input.TimeZone == nil && input.StartTime == nil && input.EndTime == nil && input.Date == nil {
+		return nil
+	}
+
+	loc := event.Zone()
+	if input.TimeZone != nil {
+		var err error
+		if loc, err = time.LoadLocation(*input.TimeZone); err != nil {
+			return ErrInvalidTimeZone
+		}
+	}
+
+	start := event.StartTime
+	if input.StartTime != nil {
+		start = input.StartTime.UTC()
+		input.StartTime = &start
+	}
+	if input.EndTime != nil {
+		end := input.EndTime.UTC()
+		input.EndTime = &end
+	}
+	if !start.IsZero() {
+		date := localMidnight(start, loc)
+		input.Date = &date
 	}
 
 	return nil
 }
 
-func (s *EventService) List(ctx context.Context, offset, limit int) ([]*models.Event, error) {
-	events, err := s.eventRepo.List(ctx, offset, limit)
+// localMidnight returns the start of the local calendar day containing t, as a
+// UTC instant.
+func localMidnight(t time.Time, loc *time.Location) time.Time {
+	local := t.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc).UTC()
+}
+
+// checkEventEditor is like checkEventOwner but also admits organizations that
+// accepted an invitation to co-host the event.
+func (s *EventService) checkEventEditor(ctx context.Context, event *models.Event, userID int64) (*models.Organization, error) {
+	org, err := s.organizationRepo.GetByUserID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
+	if org == nil {
+		return nil, ErrUnauthorized
+	}
 
-	return events, nil
+	isHost, err := isEventHost(ctx, s.cohostRepo, event, org.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !isHost {
+		return nil, ErrUnauthorized
+	}
+
+	return org, nil
 }
 
-func (s *EventService) ListByOrganization(ctx context.Context, organizationID int64, offset, limit int) ([]*models.Event, error) {
-	events, err := s.eventRepo.ListByOrganization(ctx, organizationID, offset, limit)
+// isEventHost reports whether the organization is the event's primary
+// organization or an accepted co-host.
+func isEventHost(ctx context.Context, cohostRepo repository.EventCohostRepository, event *models.Event, organizationID int64) (bool, error) {
+	if event.OrganizationID == organizationID {
+		return true, nil
+	}
+
+	cohost, err := cohostRepo.Get(ctx, event.ID, organizationID)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
+	return cohost != nil && cohost.Status == models.CohostStatusAccepted, nil
+}
 
-	return events, nil
+// requiresReview reports whether events published by the organization must be
+// approved by an admin first. Only trusted organizations bypass review.
+func requiresReview(org *models.Organization) bool {
+	return !org.IsTrusted
 }
 
-func (s *EventService) ListByVolunteer(ctx context.Context, volunteerID int64, offset, limit int) ([]*models.Event, error) {
-	events, err := s.eventRepo.ListByVolunteer(ctx, volunteerID, offset, limit)
+// changesContent reports whether the update changes the text, image or
+// requirements of the event, which are what review checks.
+func changesContent(event *models.Event, input *models.UpdateEventInput) bool {
+	switch {
+	case input.Title != nil && *input.Title != event.Title,
+		input.Description != nil && *input.Description != event.Description,
+		input.ShortDescription != nil && *input.ShortDescription != event.ShortDescription,
+		input.Location != nil && *input.Location != event.Location,
+		input.Category != nil && *input.Category != event.Category,
+		input.Image != nil && *input.Image != event.Image,
+		input.RequiredSkills != nil && strings.Join(*input.RequiredSkills, ",") != strings.Join(event.RequiredSkills, ","):
+		return true
+	}
+	return false
+}
+
+// ListPendingReview returns the admin moderation queue.
+func (s *EventService) ListPendingReview(ctx context.Context, offset, limit int) ([]*models.Event, error) {
+	return s.eventRepo.ListPendingReview(ctx, offset, limit)
+}
+
+// Review applies an admin's moderation decision and notifies the organization.
+func (s *EventService) Review(ctx context.Context, id int64, input *models.ReviewEventInput, adminID int64) (*models.Event, error) {
+	event, err := s.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if event.Status != models.EventStatusPendingReview {
+		return nil, ErrEventNotPendingReview
+	}
 
-	return events, nil
-}
+	reason := strings.TrimSpace(input.Reason)
+	if input.Decision != models.ReviewDecisionApprove && reason == "" {
+		return nil, ErrReviewReasonRequired
+	}
 
-func (s *EventService) Search(ctx context.Context, date time.Time, location string, skills []string, status string, category string, offset, limit int) ([]*models.Event, error) {
-	// Search in database
-	params := repository.SearchParams{
-		Date:     date,
-		Location: location,
-		Skills:   skills,
-		Status:   status,
-		Category: category,
-		Offset:   offset,
-		Limit:    limit,
+	var status models.EventStatus
+	notification := &models.CreateNotificationInput{
+		Message: reason,
+		EventID: &event.ID,
+	}
+	switch input.Decision {
+	case models.ReviewDecisionApprove:
+		status = models.EventStatusActive
+		notification.Type = models.NotificationEventApproved
+		notification.Title = fmt.Sprintf("%s has been approved", event.Title)
+	case models.ReviewDecisionReject:
+		status = models.EventStatusRejected
+		notification.Type = models.NotificationEventRejected
+		notification.Title = fmt.Sprintf("%s has been rejected", event.Title)
+	case models.ReviewDecisionRequestChanges:
+		status = models.EventStatusChangesRequested
+		notification.Type = models.NotificationEventChangesRequested
+		notification.Title = fmt.Sprintf("Changes requested for %s", event.Title)
+	default:
+		return nil, errors.New("invalid review decision")
 	}
 
-	events, err := s.eventRepo.Search(ctx, params)
+	// Another admin may have decided in the meantime
+	event, err = s.eventRepo.Review(ctx, id, status, reason, adminID)
 	if err != nil {
 		return nil, err
 	}
+	if event == nil {
+		return nil, ErrEventNotPendingReview
+	}
 
-	return events, nil
+	org, err := s.organizationRepo.GetByID(ctx, event.OrganizationID)
+	if err == nil && org != nil {
+		notification.UserID = org.UserID
+		if _, err := s.notificationService.Notify(ctx, notification); err != nil {
+			// Log error but don't fail the operation
+			// logger.Error("Failed to notify organization of review", err)
+		}
+	}
+
+	return event, nil
 }
 
-func (s *EventService) RegisterForEvent(ctx context.Context, eventID, volunteerID int64) (*models.EventRegistration, error) {
-	event, err := s.GetByID(ctx, eventID)
+// cancel cancels the event and its registrations, applying any other changes
+// in input, which may be nil.
+func (s *EventService) cancel(ctx context.Context, id int64, message string, input *models.UpdateEventInput) (*models.Event, error) {
+	// The event and its registrations are cancelled in one transaction;
+	// registrants are only notified once it has committed
+	event, registrations, err := s.eventRepo.Cancel(ctx, id, message, input)
 	if err != nil {
 		return nil, err
 	}
+	if event == nil {
+		return nil, ErrEventNotFound
+	}
 
-	// Check if volunteer exists
-	volunteer, err := s.volunteerRepo.GetByID(ctx, volunteerID)
-	if err != nil {
-		return nil, err
+	s.registrationService.invalidateCancelled(ctx, id, registrations)
+
+	if message == "" {
+		message = "The organizer has cancelled this event."
 	}
-	if volunteer == nil {
-		return nil, errors.New("volunteer not found")
+	for _, registration := range registrations {
+		notification := &models.CreateNotificationInput{
+			Type:    models.NotificationEventCancelled,
+			Title:   fmt.Sprintf("%s has been cancelled", event.Title),
+			Message: message,
+			EventID: &event.ID,
+		}
+		if _, err := s.notificationService.NotifyVolunteer(ctx, registration.VolunteerID, notification); err != nil {
+			// Log error but don't fail the operation
+			// logger.Error("Failed to notify registrant of cancellation", err)
+		}
 	}
 
-	// Check event capacity
-	registrations, err := s.eventRegRepo.ListByEvent(ctx, eventID, 0, 1000)
+	return event, nil
+}
+
+func (s *EventService) List(ctx context.Context, offset, limit int) ([]*models.Event, error) {
+	events, err := s.eventRepo.List(ctx, offset, limit)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(registrations) >= event.VolunteersNeeded {
-		return nil, ErrEventCapacityFull
-	}
+	return events, nil
+}
 
-	// Check if already registered
-	existingReg, err := s.eventRegRepo.GetByEventAndVolunteer(ctx, eventID, volunteerID)
+func (s *EventService) ListByOrganization(ctx context.Context, organizationID int64, offset, limit int) ([]*models.Event, error) {
+	events, err := s.eventRepo.ListByOrganization(ctx, organizationID, offset, limit)
 	if err != nil {
 		return nil, err
 	}
-	if existingReg != nil {
-		return existingReg, nil // Already registered
+
+	return events, nil
+}
+
+func (s *EventService) ListByVolunteer(ctx context.Context, volunteerID int64, offset, limit int) ([]*models.Event, error) {
+	events, err := s.eventRepo.ListByVolunteer(ctx, volunteerID, offset, limit)
+	if err != nil {
+		return nil, err
 	}
 
-	// Create registration
-	input := &models.CreateEventRegistrationInput{
-		EventID:     eventID,
-		VolunteerID: volunteerID,
-		Status:      "registered",
+	return events, nil
+}
+
+// Search filters events; from and to are inclusive calendar days matched
+// against each event's local start date.
+func (s *EventService) Search(ctx context.Context, from, to time.Time, location string, skills []string, status string, category string, offset, limit int) ([]*models.Event, error) {
+	// Search in database
+	params := repository.SearchParams{
+		DateFrom: from,
+		DateTo:   to,
+		Location: location,
+		Skills:   skills,
+		Status:   status,
+		Category: category,
+		Offset:   offset,
+		Limit:    limit,
 	}
 
-	registration, err := s.eventRegRepo.Create(ctx, input)
+	events, err := s.eventRepo.Search(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
-	return registration, nil
+	return events, nil
 }
 
+// CheckCapacity reports whether the event still has a free seat.
 func (s *EventService) CheckCapacity(ctx context.Context, eventID int64) (bool, error) {
 	event, err := s.GetByID(ctx, eventID)
 	if err != nil {
 		return false, err
 	}
 
-	registrations, err := s.eventRegRepo.ListByEvent(ctx, eventID, 0, 1000)
+	taken, err := s.eventRegRepo.CountByEvent(ctx, eventID)
 	if err != nil {
 		return false, err
 	}
 
-	return len(registrations) < event.VolunteersNeeded, nil
+	return taken < event.VolunteersNeeded, nil
 }
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+)
+
+var (
+	ErrCohostInviteNotFound = errors.New("co-host invitation not found")
+	ErrCannotCohostOwnEvent = errors.New("an organization cannot co-host its own event")
+	ErrAlreadyCohost        = errors.New("organization already co-hosts this event")
+)
+
+type EventCohostService struct {
+	cohostRepo          repository.EventCohostRepository
+	organizationRepo    repository.OrganizationRepository
+	eventService        *EventService
+	notificationService *NotificationService
+}
+
+func NewEventCohostService(
+	cohostRepo repository.EventCohostRepository,
+	organizationRepo repository.OrganizationRepository,
+	eventService *EventService,
+	notificationService *NotificationService) *EventCohostService {
+	return &EventCohostService{
+		cohostRepo:          cohostRepo,
+		organizationRepo:    organizationRepo,
+		eventService:        eventService,
+		notificationService: notificationService,
+	}
+}
+
+func (s *EventCohostService) ListByEvent(ctx context.Context, eventID int64) ([]*models.EventCohost, error) {
+	if _, err := s.eventService.GetByID(ctx, eventID); err != nil {
+		return nil, err
+	}
+
+	return s.cohostRepo.ListByEvent(ctx, eventID)
+}
+
+// ListInvitations returns the unanswered co-host invitations of the user's organization.
+func (s *EventCohostService) ListInvitations(ctx context.Context, userID int64) ([]*models.EventCohost, error) {
+	org, err := s.organizationRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, ErrUnauthorized
+	}
+
+	return s.cohostRepo.ListPendingByOrganization(ctx, org.ID)
+}
+
+// Invite asks another organization to co-host the event. Only the primary
+// organization can invite.
+func (s *EventCohostService) Invite(ctx context.Context, eventID int64, input *models.InviteCohostInput, userID int64) (*models.EventCohost, error) {
+	event, err := s.eventService.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := s.eventService.checkEventOwner(ctx, event, userID)
+	if err != nil {
+		return nil, err
+	}
+	if input.OrganizationID == owner.ID {
+		return nil, ErrCannotCohostOwnEvent
+	}
+
+	invitee, err := s.organizationRepo.GetByID(ctx, input.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if invitee == nil {
+		return nil, ErrOrganizationNotFound
+	}
+
+	existing, err := s.cohostRepo.Get(ctx, eventID, input.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.Status == models.CohostStatusAccepted {
+		return nil, ErrAlreadyCohost
+	}
+
+	cohost, err := s.cohostRepo.Invite(ctx, eventID, input.OrganizationID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.notificationService.Notify(ctx, &models.CreateNotificationInput{
+		UserID:  invitee.UserID,
+		Type:    models.NotificationCohostInvited,
+		Title:   fmt.Sprintf("%s invited you to co-host %s", owner.Name, event.Title),
+		EventID: &event.ID,
+	}); err != nil {
+		// Log error but don't fail the operation
+		// logger.Error("Failed to notify invited co-host", err)
+	}
+
+	return cohost, nil
+}
+
+// Respond accepts or declines an invitation on behalf of the user's organization.
+func (s *EventCohostService) Respond(ctx context.Context, eventID int64, input *models.RespondCohostInput, userID int64) (*models.EventCohost, error) {
+	event, err := s.eventService.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := s.organizationRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, ErrUnauthorized
+	}
+
+	invitation, err := s.cohostRepo.Get(ctx, eventID, org.ID)
+	if err != nil {
+		return nil, err
+	}
+	if invitation == nil || invitation.Status != models.CohostStatusPending {
+		return nil, ErrCohostInviteNotFound
+	}
+
+	status := models.CohostStatusDeclined
+	if *input.Accept {
+		status = models.CohostStatusAccepted
+	}
+	cohost, err := s.cohostRepo.Respond(ctx, eventID, org.ID, status)
+	if err != nil {
+		return nil, err
+	}
+
+	// Let the primary organization know
+	owner, err := s.organizationRepo.GetByID(ctx, event.OrganizationID)
+	if err == nil && owner != nil {
+		if _, err := s.notificationService.Notify(ctx, &models.CreateNotificationInput{
+			UserID:  owner.UserID,
+			Type:    models.NotificationCohostResponded,
+			Title:   fmt.Sprintf("%s %s your invitation to co-host %s", org.Name, status, event.Title),
+			EventID: &event.ID,
+		}); err != nil {
+			// logger.Error("Failed to notify event organizer", err)
+		}
+	}
+
+	return cohost, nil
+}
+
+// Remove ends a co-hosting arrangement. The primary organization can remove
+// any co-host, and a co-host can withdraw itself.
+func (s *EventCohostService) Remove(ctx context.Context, eventID, organizationID int64, userID int64) error {
+	event, err := s.eventService.GetByID(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	org, err := s.organizationRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if org == nil || (org.ID != event.OrganizationID && org.ID != organizationID) {
+		return ErrUnauthorized
+	}
+
+	cohost, err := s.cohostRepo.Get(ctx, eventID, organizationID)
+	if err != nil {
+		return err
+	}
+	if cohost == nil {
+		return ErrCohostInviteNotFound
+	}
+
+	return s.cohostRepo.Delete(ctx, eventID, organizationID)
+}
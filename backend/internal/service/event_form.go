@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+)
+
+var (
+	ErrEventFormNotFound = errors.New("event has no registration form")
+)
+
+// FormValidationError lists every problem found in a form definition or in a
+// set of answers, keyed by question ID.
+type FormValidationError struct {
+	Fields map[string]string
+}
+
+func (e *FormValidationError) Error() string {
+	keys := make([]string, 0, len(e.Fields))
+	for key := range e.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = fmt.Sprintf("%s: %s", key, e.Fields[key])
+	}
+	return "invalid form data: " + strings.Join(parts, "; ")
+}
+
+type EventFormService struct {
+	formRepo     repository.EventFormRepository
+	eventRegRepo repository.EventRegistrationRepository
+	eventService *EventService
+}
+
+func NewEventFormService(formRepo repository.EventFormRepository, eventRegRepo repository.EventRegistrationRepository, eventService *EventService) *EventFormService {
+	return &EventFormService{
+		formRepo:     formRepo,
+		eventRegRepo: eventRegRepo,
+		eventService: eventService,
+	}
+}
+
+// GetCurrent returns the latest form version for the event, or nil if the
+// event asks no questions.
+func (s *EventFormService) GetCurrent(ctx context.Context, eventID int64) (*models.EventForm, error) {
+	return s.formRepo.GetCurrent(ctx, eventID)
+}
+
+func (s *EventFormService) ListVersions(ctx context.Context, eventID int64, userID int64) ([]*models.EventForm, error) {
+	if err := s.checkOwner(ctx, eventID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.formRepo.ListByEvent(ctx, eventID)
+}
+
+// Save validates the questions and stores them as a new form version.
+func (s *EventFormService) Save(ctx context.Context, eventID int64, input *models.SaveEventFormInput, userID int64) (*models.EventForm, error) {
+	if err := s.checkOwner(ctx, eventID, userID); err != nil {
+		return nil, err
+	}
+
+	if err := validateQuestions(input.Questions); err != nil {
+		return nil, err
+	}
+
+	return s.formRepo.Create(ctx, eventID, input.Questions, userID)
+}
+
+// ExportAnswers flattens every registration's answers for the event into one row per
+// question, labelled with the form version the volunteer answered.
+func (s *EventFormService) ExportAnswers(ctx context.Context, eventID int64, userID int64) ([]*models.RegistrationAnswerExport, error) {
+	if err := s.checkOwner(ctx, eventID, userID); err != nil {
+		return nil, err
+	}
+
+	forms, err := s.formRepo.ListByEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	formsByID := make(map[int64]*models.EventForm)
+	for _, form := range forms {
+		formsByID[form.ID] = form
+	}
+
+	registrations, err := s.eventRegRepo.ListByEvent(ctx, eventID, 0, 10000)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []*models.RegistrationAnswerExport
+	for _, registration := range registrations {
+		if registration.FormID == nil {
+			continue
+		}
+		form, ok := formsByID[*registration.FormID]
+		if !ok {
+			continue
+		}
+
+		for _, question := range form.Questions {
+			value, answered := registration.Answers[question.ID]
+			answer := ""
+			if answered {
+				answer = formatAnswer(value)
+			}
+			rows = append(rows, &models.RegistrationAnswerExport{
+				RegistrationID: registration.ID,
+				VolunteerID:    registration.VolunteerID,
+				Status:         registration.Status,
+				FormVersion:    form.Version,
+				QuestionID:     question.ID,
+				QuestionLabel:  question.Label,
+				Answer:         answer,
+			})
+		}
+	}
+
+	return rows, nil
+}
+
+func (s *EventFormService) checkOwner(ctx context.Context, eventID int64, userID int64) error {
+	event, err := s.eventService.GetByID(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.eventService.checkEventEditor(ctx, event, userID)
+	return err
+}
+
+func validateQuestions(questions []models.FormQuestion) error {
+	problems := make(map[string]string)
+	seen := make(map[string]bool)
+
+	for i, question := range questions {
+		key := question.ID
+		if strings.TrimSpace(key) == "" {
+			problems[fmt.Sprintf("questions[%d]", i)] = "id is required"
+			continue
+		}
+		if seen[key] {
+			problems[key] = "duplicate question id"
+			continue
+		}
+		seen[key] = true
+
+		switch question.Type {
+		case models.QuestionTypeSingleChoice, models.QuestionTypeMultiChoice:
+			if len(question.Options) == 0 {
+				problems[key] = "choice questions need at least one option"
+			}
+		case models.QuestionTypeText, models.QuestionTypeNumber, models.QuestionTypeBoolean:
+			if len(question.Options) > 0 {
+				problems[key] = "options are only allowed on choice questions"
+			}
+		default:
+			problems[key] = fmt.Sprintf("unsupported question type %q", question.Type)
+		}
+	}
+
+	if len(problems) > 0 {
+		return &FormValidationError{Fields: problems}
+	}
+	return nil
+}
+
+func validateAnswers(questions []models.FormQuestion, answers map[string]interface{}) error {
+	problems := make(map[string]string)
+	known := make(map[string]bool)
+
+	for _, question := range questions {
+		known[question.ID] = true
+
+		value, answered := answers[question.ID]
+		if !answered || value == nil {
+			if question.Required {
+				problems[question.ID] = "an answer is required"
+			}
+			continue
+		}
+
+		if problem := validateAnswer(question, value); problem != "" {
+			problems[question.ID] = problem
+		}
+	}
+
+	for key := range answers {
+		if !known[key] {
+			problems[key] = "unknown question"
+		}
+	}
+
+	if len(problems) > 0 {
+		return &FormValidationError{Fields: problems}
+	}
+	return nil
+}
+
+func validateAnswer(question models.FormQuestion, value interface{}) string {
+	switch question.Type {
+	case models.QuestionTypeText:
+		text, ok := value.(string)
+		if !ok {
+			return "must be text"
+		}
+		if question.Required && strings.TrimSpace(text) == "" {
+			return "an answer is required"
+		}
+	case models.QuestionTypeNumber:
+		if _, ok := value.(float64); !ok {
+			return "must be a number"
+		}
+	case models.QuestionTypeBoolean:
+		if _, ok := value.(bool); !ok {
+			return "must be true or false"
+		}
+	case models.QuestionTypeSingleChoice:
+		choice, ok := value.(string)
+		if !ok || !containsString(question.Options, choice) {
+			return "must be one of the listed options"
+		}
+	case models.QuestionTypeMultiChoice:
+		choices, ok := value.([]interface{})
+		if !ok {
+			return "must be a list of options"
+		}
+		if question.Required && len(choices) == 0 {
+			return "select at least one option"
+		}
+		for _, item := range choices {
+			choice, ok := item.(string)
+			if !ok || !containsString(question.Options, choice) {
+				return "must only contain listed options"
+			}
+		}
+	}
+	return ""
+}
+
+func formatAnswer(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = formatAnswer(item)
+		}
+		return strings.Join(parts, "; ")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+)
+
+var (
+	ErrAnnouncementNotFound = errors.New("announcement not found")
+	ErrInvalidFeedCursor    = errors.New("invalid feed cursor")
+)
+
+type FeedService struct {
+	feedRepo         repository.FeedRepository
+	volunteerRepo    VolunteerRepository
+	organizationRepo repository.OrganizationRepository
+}
+
+func NewFeedService(
+	feedRepo repository.FeedRepository,
+	volunteerRepo VolunteerRepository,
+	organizationRepo repository.OrganizationRepository) *FeedService {
+	return &FeedService{
+		feedRepo:         feedRepo,
+		volunteerRepo:    volunteerRepo,
+		organizationRepo: organizationRepo,
+	}
+}
+
+// Follow follows the organization, or changes whether the volunteer shares
+// their profile with it when they already follow it.
+func (s *FeedService) Follow(ctx context.Context, organizationID int64, input *models.FollowOrganizationInput, userID int64) (*models.OrganizationFollow, error) {
+	volunteer, err := s.volunteer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	organization, err := s.organizationRepo.GetByID(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if organization == nil {
+		return nil, ErrOrganizationNotFound
+	}
+
+	follow, err := s.feedRepo.GetFollow(ctx, organizationID, volunteer.ID)
+	if err != nil {
+		return nil, err
+	}
+	if follow == nil {
+		follow = &models.OrganizationFollow{OrganizationID: organizationID, VolunteerID: volunteer.ID}
+	}
+	follow.ShareProfile = input.ShareProfile
+	if err := s.feedRepo.Follow(ctx, follow); err != nil {
+		return nil, err
+	}
+	follow.OrganizationName = organization.Name
+	return follow, nil
+}
+
+// Unfollow stops following the organization. Unfollowing an organization the
+// volunteer does not follow does nothing.
+func (s *FeedService) Unfollow(ctx context.Context, organizationID, userID int64) error {
+	volunteer, err := s.volunteer(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return s.feedRepo.Unfollow(ctx, organizationID, volunteer.ID)
+}
+
+func (s *FeedService) ListFollowing(ctx context.Context, userID int64) ([]*models.OrganizationFollow, error) {
+	volunteer, err := s.volunteer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.feedRepo.ListFollowing(ctx, volunteer.ID)
+}
+
+// ListFollowers returns the organization's followers who chose to share
+// their profile with it. The total is on the organization's follower_count.
+func (s *FeedService) ListFollowers(ctx context.Context, organizationID, userID int64, role string, offset, limit int) ([]*models.OrganizationFollower, error) {
+	if _, err := s.manageOrganization(ctx, organizationID, userID, role); err != nil {
+		return nil, err
+	}
+	return s.feedRepo.ListFollowers(ctx, organizationID, offset, limit)
+}
+
+// CreateAnnouncement posts an announcement to the organization's followers,
+// or to every volunteer when organizationID is nil.
+func (s *FeedService) CreateAnnouncement(ctx context.Context, organizationID *int64, input *models.AnnouncementInput, userID int64, role string) (*models.Announcement, error) {
+	announcement := &models.Announcement{
+		OrganizationID: organizationID,
+		Title:          strings.TrimSpace(input.Title),
+		Body:           strings.TrimSpace(input.Body),
+		CreatedBy:      userID,
+	}
+	if organizationID == nil {
+		if models.Role(role) != models.RoleAdmin {
+			return nil, ErrUnauthorized
+		}
+	} else {
+		organization, err := s.manageOrganization(ctx, *organizationID, userID, role)
+		if err != nil {
+			return nil, err
+		}
+		announcement.OrganizationName = organization.Name
+	}
+
+	if err := s.feedRepo.CreateAnnouncement(ctx, announcement); err != nil {
+		return nil, err
+	}
+	return announcement, nil
+}
+
+func (s *FeedService) ListAnnouncements(ctx context.Context, organizationID *int64, offset, limit int) ([]*models.Announcement, error) {
+	if organizationID != nil {
+		organization, err := s.organizationRepo.GetByID(ctx, *organizationID)
+		if err != nil {
+			return nil, err
+		}
+		if organization == nil {
+			return nil, ErrOrganizationNotFound
+		}
+	}
+	return s.feedRepo.ListAnnouncements(ctx, organizationID, offset, limit)
+}
+
+func (s *FeedService) DeleteAnnouncement(ctx context.Context, id, userID int64, role string) error {
+	announcement, err := s.feedRepo.GetAnnouncement(ctx, id)
+	if err != nil {
+		return err
+	}
+	if announcement == nil {
+		return ErrAnnouncementNotFound
+	}
+
+	if announcement.OrganizationID == nil {
+		if models.Role(role) != models.RoleAdmin {
+			return ErrUnauthorized
+		}
+	} else if _, err := s.manageOrganization(ctx, *announcement.OrganizationID, userID, role); err != nil {
+		return err
+	}
+	return s.feedRepo.DeleteAnnouncement(ctx, id)
+}
+
+// Feed returns a page of the volunteer's activity feed, newest first. Pass
+// the previous page's next_cursor to continue.
+func (s *FeedService) Feed(ctx context.Context, userID int64, cursor string, limit int) (*models.FeedPage, error) {
+	volunteer, err := s.volunteer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var after *models.FeedCursor
+	if cursor != "" {
+		if after, err = decodeFeedCursor(cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	// Ask for one more item than needed to know whether there is a next page
+	items, err := s.feedRepo.ListFeed(ctx, volunteer.ID, userID, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &models.FeedPage{Items: items}
+	if len(items) > limit {
+		page.Items = items[:limit]
+		last := page.Items[limit-1]
+		page.NextCursor = encodeFeedCursor(&models.FeedCursor{OccurredAt: last.OccurredAt, Kind: last.Kind, ID: last.ID})
+	}
+	return page, nil
+}
+
+func (s *FeedService) volunteer(ctx context.Context, userID int64) (*models.Volunteer, error) {
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+	return volunteer, nil
+}
+
+// manageOrganization loads an organization the user may act for: their own,
+// or any when they are an admin.
+func (s *FeedService) manageOrganization(ctx context.Context, organizationID, userID int64, role string) (*models.Organization, error) {
+	organization, err := s.organizationRepo.GetByID(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if organization == nil {
+		return nil, ErrOrganizationNotFound
+	}
+	if organization.UserID != userID && models.Role(role) != models.RoleAdmin {
+		return nil, ErrUnauthorized
+	}
+	return organization, nil
+}
+
+// encodeFeedCursor packs the position of a feed item into an opaque string.
+func encodeFeedCursor(cursor *models.FeedCursor) string {
+	raw := fmt.Sprintf("%d:%s:%d", cursor.OccurredAt.UnixMicro(), cursor.Kind, cursor.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeFeedCursor(cursor string) (*models.FeedCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidFeedCursor
+	}
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 3 {
+		return nil, ErrInvalidFeedCursor
+	}
+	micros, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidFeedCursor
+	}
+	id, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidFeedCursor
+	}
+	return &models.FeedCursor{
+		OccurredAt: time.UnixMicro(micros).UTC(),
+		Kind:       models.FeedItemKind(parts[1]),
+		ID:         id,
+	}, nil
+}
@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"volunteer-management/internal/config"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+)
+
+var (
+	ErrFeedbackNotFound     = errors.New("feedback not found")
+	ErrEventNotComplete     = errors.New("feedback opens once the event is complete")
+	ErrFeedbackWindowClosed = errors.New("the feedback window for this event has closed")
+	ErrNotEventParticipant  = errors.New("volunteer did not take part in this event")
+	ErrCannotReportOwn      = errors.New("you cannot report your own feedback")
+)
+
+// reportCommentLimit caps the recent comments included in a feedback report.
+const reportCommentLimit = 20
+
+type FeedbackService struct {
+	feedbackRepo     repository.FeedbackRepository
+	eventService     *EventService
+	organizationRepo repository.OrganizationRepository
+	volunteerRepo    VolunteerRepository
+	cfg              config.FeedbackConfig
+}
+
+func NewFeedbackService(
+	feedbackRepo repository.FeedbackRepository,
+	eventService *EventService,
+	organizationRepo repository.OrganizationRepository,
+	volunteerRepo VolunteerRepository,
+	cfg config.FeedbackConfig) *FeedbackService {
+	return &FeedbackService{
+		feedbackRepo:     feedbackRepo,
+		eventService:     eventService,
+		organizationRepo: organizationRepo,
+		volunteerRepo:    volunteerRepo,
+		cfg:              cfg,
+	}
+}
+
+// Submit records the volunteer's ratings of a completed event and of the
+// organization that hosted them. Submitting again replaces the earlier feedback.
+func (s *FeedbackService) Submit(ctx context.Context, eventID int64, input *models.SubmitFeedbackInput, userID int64) (*models.EventFeedback, error) {
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+
+	event, err := s.eventService.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.Status != models.EventStatusComplete {
+		return nil, ErrEventNotComplete
+	}
+	if s.cfg.Window > 0 && time.Since(event.EndTime) > s.cfg.Window {
+		return nil, ErrFeedbackWindowClosed
+	}
+
+	participant, err := s.feedbackRepo.GetParticipant(ctx, eventID, volunteer.ID)
+	if err != nil {
+		return nil, err
+	}
+	if participant == nil {
+		return nil, ErrNotEventParticipant
+	}
+
+	organizationID := event.OrganizationID
+	if participant.HostOrganizationID != nil {
+		organizationID = *participant.HostOrganizationID
+	}
+
+	comment := strings.TrimSpace(input.Comment)
+	status := models.FeedbackStatusVisible
+	if s.containsBlockedTerm(comment) {
+		status = models.FeedbackStatusFlagged
+	}
+
+	return s.feedbackRepo.Save(ctx, &models.EventFeedback{
+		EventID:            eventID,
+		VolunteerID:        volunteer.ID,
+		OrganizationID:     organizationID,
+		EventRating:        input.EventRating,
+		OrganizationRating: input.OrganizationRating,
+		Comment:            comment,
+		Status:             status,
+	})
+}
+
+// GetMine returns the volunteer's own feedback for the event, whatever its
+// moderation status.
+func (s *FeedbackService) GetMine(ctx context.Context, eventID, userID int64) (*models.EventFeedback, error) {
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+
+	feedback, err := s.feedbackRepo.GetByEventAndVolunteer(ctx, eventID, volunteer.ID)
+	if err != nil {
+		return nil, err
+	}
+	if feedback == nil {
+		return nil, ErrFeedbackNotFound
+	}
+	return feedback, nil
+}
+
+// ListByEvent lists an event's feedback. Only admins see comments that are
+// flagged or hidden.
+func (s *FeedbackService) ListByEvent(ctx context.Context, eventID int64, role string, offset, limit int) ([]*models.EventFeedback, error) {
+	if _, err := s.eventService.GetByID(ctx, eventID); err != nil {
+		return nil, err
+	}
+
+	return s.feedbackRepo.ListByEvent(ctx, eventID, models.Role(role) != models.RoleAdmin, offset, limit)
+}
+
+// Report flags abusive feedback once enough distinct users report it.
+func (s *FeedbackService) Report(ctx context.Context, id, userID int64) error {
+	feedback, err := s.feedbackRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if feedback == nil {
+		return ErrFeedbackNotFound
+	}
+
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if volunteer != nil && volunteer.ID == feedback.VolunteerID {
+		return ErrCannotReportOwn
+	}
+
+	_, err = s.feedbackRepo.Report(ctx, id, userID, s.cfg.ReportThreshold)
+	return err
+}
+
+func (s *FeedbackService) ListModerationQueue(ctx context.Context, offset, limit int) ([]*models.EventFeedback, error) {
+	return s.feedbackRepo.ListByStatus(ctx, models.FeedbackStatusFlagged, offset, limit)
+}
+
+// Moderate approves a comment, making it visible, or hides it. Approved
+// comments are not flagged again by later reports.
+func (s *FeedbackService) Moderate(ctx context.Context, id int64, input *models.ModerateFeedbackInput, adminID int64) (*models.EventFeedback, error) {
+	status := models.FeedbackStatusVisible
+	if input.Action == "hide" {
+		status = models.FeedbackStatusHidden
+	}
+
+	feedback, err := s.feedbackRepo.Moderate(ctx, id, status, input.Note, adminID)
+	if err != nil {
+		return nil, err
+	}
+	if feedback == nil {
+		return nil, ErrFeedbackNotFound
+	}
+	return feedback, nil
+}
+
+// RateVolunteer records the host organization's private rating of a volunteer
+// who took part in a completed event.
+func (s *FeedbackService) RateVolunteer(ctx context.Context, eventID, volunteerID int64, input *models.RateVolunteerInput, userID int64) (*models.VolunteerRating, error) {
+	event, err := s.eventService.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := s.eventService.checkEventEditor(ctx, event, userID)
+	if err != nil {
+		return nil, err
+	}
+	if event.Status != models.EventStatusComplete {
+		return nil, ErrEventNotComplete
+	}
+
+	participant, err := s.feedbackRepo.GetParticipant(ctx, eventID, volunteerID)
+	if err != nil {
+		return nil, err
+	}
+	if participant == nil {
+		return nil, ErrNotEventParticipant
+	}
+
+	rating, err := s.feedbackRepo.SaveVolunteerRating(ctx, &models.VolunteerRating{
+		EventID:        eventID,
+		VolunteerID:    volunteerID,
+		OrganizationID: org.ID,
+		Rating:         input.Rating,
+		Note:           strings.TrimSpace(input.Note),
+		RatedBy:        userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rating, nil
+}
+
+// GetVolunteerRatings summarizes the ratings organizations gave a volunteer.
+// Callers must restrict this to organizations and admins.
+func (s *FeedbackService) GetVolunteerRatings(ctx context.Context, volunteerID int64) (*models.VolunteerRatingSummary, error) {
+	volunteer, err := s.volunteerRepo.GetByID(ctx, volunteerID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+
+	ratings, err := s.feedbackRepo.ListVolunteerRatings(ctx, volunteerID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &models.VolunteerRatingSummary{
+		VolunteerID: volunteerID,
+		RatingCount: len(ratings),
+		Ratings:     ratings,
+	}
+	if len(ratings) > 0 {
+		total := 0
+		for _, rating := range ratings {
+			total += rating.Rating
+		}
+		summary.AverageRating = float64(total) / float64(len(ratings))
+	}
+	return summary, nil
+}
+
+// GetReport builds the feedback report of an organization for its own staff
+// or an admin.
+func (s *FeedbackService) GetReport(ctx context.Context, orgID int64, filter models.FeedbackReportFilter, userID int64, role string) (*models.FeedbackReport, error) {
+	org, err := s.organizationRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, ErrOrganizationNotFound
+	}
+	if models.Role(role) != models.RoleAdmin && org.UserID != userID {
+		return nil, ErrUnauthorized
+	}
+
+	report, err := s.feedbackRepo.GetOrganizationReport(ctx, orgID, filter, reportCommentLimit)
+	if err != nil {
+		return nil, err
+	}
+	if !filter.From.IsZero() {
+		report.From = &filter.From
+	}
+	if !filter.To.IsZero() {
+		report.To = &filter.To
+	}
+	return report, nil
+}
+
+// containsBlockedTerm reports whether the comment contains any configured
+// blocked term, ignoring case.
+func (s *FeedbackService) containsBlockedTerm(comment string) bool {
+	if comment == "" {
+		return false
+	}
+	lower := strings.ToLower(comment)
+	for _, term := range s.cfg.BlockedTerms {
+		if strings.Contains(lower, strings.ToLower(term)) {
+			return true
+		}
+	}
+	return false
+}
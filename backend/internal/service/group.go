@@ -0,0 +1,718 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+	"volunteer-management/pkg/cache"
+)
+
+var (
+	ErrGroupNotFound           = errors.New("group not found")
+	ErrGroupMemberNotFound     = errors.New("volunteer is not a member of this group")
+	ErrNotGroupLeader          = errors.New("only group leaders can do this")
+	ErrLastGroupLeader         = errors.New("a group needs at least one leader")
+	ErrGroupInvitationNotFound = errors.New("invitation not found")
+	ErrGroupInvitationInvalid  = errors.New("invitation has expired or is no longer valid")
+	ErrGroupSignupNotFound     = errors.New("group signup not found")
+	ErrGroupSignupFormRequired = errors.New("event asks questions each volunteer must answer; members must sign up individually")
+	ErrGroupSignupNoMembers    = errors.New("no members to sign up")
+)
+
+// groupInvitationTTL is how long an invitation token stays valid.
+const groupInvitationTTL = 14 * 24 * time.Hour
+
+// GroupCapacityError reports that an all-or-nothing group signup did not fit
+// in the seats left.
+type GroupCapacityError struct {
+	Requested int
+	SeatsLeft int
+}
+
+func (e *GroupCapacityError) Error() string {
+	return fmt.Sprintf("event has %d seats left for %d members", e.SeatsLeft, e.Requested)
+}
+
+// GroupSignupIneligibleError lists the members an all-or-nothing group signup
+// could not include, keyed by volunteer ID.
+type GroupSignupIneligibleError struct {
+	Members map[string]string
+}
+
+func (e *GroupSignupIneligibleError) Error() string {
+	return "some members cannot be signed up"
+}
+
+type GroupService struct {
+	groupRepo           repository.GroupRepository
+	registrationService *RegistrationService
+	eventService        *EventService
+	volunteerRepo       VolunteerRepository
+	userRepo            repository.UserRepository
+	notificationService *NotificationService
+	badgeService        *BadgeService
+	progressionService  *ProgressionService
+}
+
+func NewGroupService(
+	groupRepo repository.GroupRepository,
+	registrationService *RegistrationService,
+	eventService *EventService,
+	volunteerRepo VolunteerRepository,
+	userRepo repository.UserRepository,
+	notificationService *NotificationService,
+	badgeService *BadgeService,
+	progressionService *ProgressionService) *GroupService {
+	return &GroupService{
+		groupRepo:           groupRepo,
+		registrationService: registrationService,
+		eventService:        eventService,
+		volunteerRepo:       volunteerRepo,
+		userRepo:            userRepo,
+		notificationService: notificationService,
+		badgeService:        badgeService,
+		progressionService:  progressionService,
+	}
+}
+
+func (s *GroupService) Create(ctx context.Context, input *models.CreateGroupInput, userID int64) (*models.VolunteerGroup, error) {
+	volunteer, err := s.currentVolunteer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.groupRepo.Create(ctx, input, volunteer.ID)
+}
+
+func (s *GroupService) ListMine(ctx context.Context, userID int64) ([]*models.VolunteerGroup, error) {
+	volunteer, err := s.currentVolunteer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.groupRepo.ListByVolunteer(ctx, volunteer.ID)
+}
+
+// GetByID returns the group with its members to members and admins.
+func (s *GroupService) GetByID(ctx context.Context, id, userID int64, role string) (*models.VolunteerGroup, error) {
+	group, err := s.getGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if models.Role(role) != models.RoleAdmin {
+		if _, err := s.checkMember(ctx, id, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	group.Members, err = s.groupRepo.ListMembers(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (s *GroupService) Update(ctx context.Context, id int64, input *models.UpdateGroupInput, userID int64) (*models.VolunteerGroup, error) {
+	if _, err := s.checkLeader(ctx, id, userID); err != nil {
+		return nil, err
+	}
+
+	return s.groupRepo.Update(ctx, id, input)
+}
+
+func (s *GroupService) Delete(ctx context.Context, id, userID int64) error {
+	if _, err := s.checkLeader(ctx, id, userID); err != nil {
+		return err
+	}
+
+	return s.groupRepo.Delete(ctx, id)
+}
+
+// Invite creates an invitation per email. The tokens are returned only here;
+// the leader shares them with people who may not have an account yet.
+// Invitees who already have an account are also notified.
+func (s *GroupService) Invite(ctx context.Context, id int64, input *models.InviteGroupMembersInput, userID int64) ([]*models.GroupInvitation, error) {
+	leader, err := s.checkLeader(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	group, err := s.getGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	seen := make(map[string]bool, len(input.Emails))
+	var invitations []*models.GroupInvitation
+	for _, email := range input.Emails {
+		email = strings.ToLower(strings.TrimSpace(email))
+		if seen[email] {
+			continue
+		}
+		seen[email] = true
+
+		token, err := newInvitationToken()
+		if err != nil {
+			return nil, err
+		}
+		invitations = append(invitations, &models.GroupInvitation{
+			GroupID:   id,
+			Email:     email,
+			Token:     token,
+			TokenHash: hashInvitationToken(token),
+			Status:    models.GroupInvitationPending,
+			InvitedBy: leader.VolunteerID,
+			ExpiresAt: now.Add(groupInvitationTTL),
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+	}
+
+	if err := s.groupRepo.CreateInvitations(ctx, invitations); err != nil {
+		return nil, err
+	}
+
+	for _, invitation := range invitations {
+		user, err := s.userRepo.GetByEmail(ctx, invitation.Email)
+		if err != nil || user == nil {
+			continue
+		}
+		notification := &models.CreateNotificationInput{
+			UserID:  user.ID,
+			Type:    models.NotificationGroupInvited,
+			Title:   fmt.Sprintf("You're invited to join %s", group.Name),
+			Message: "Open the invitation from your group leader to join.",
+		}
+		if _, err := s.notificationService.Notify(ctx, notification); err != nil {
+			// Log error but don't fail the operation
+			// logger.Error("Failed to notify group invitee", err)
+		}
+	}
+
+	return invitations, nil
+}
+
+func (s *GroupService) ListInvitations(ctx context.Context, id, userID int64) ([]*models.GroupInvitation, error) {
+	if _, err := s.checkLeader(ctx, id, userID); err != nil {
+		return nil, err
+	}
+
+	return s.groupRepo.ListInvitations(ctx, id)
+}
+
+func (s *GroupService) RevokeInvitation(ctx context.Context, id, invitationID, userID int64) error {
+	if _, err := s.checkLeader(ctx, id, userID); err != nil {
+		return err
+	}
+
+	invitation, err := s.groupRepo.GetInvitation(ctx, invitationID)
+	if err != nil {
+		return err
+	}
+	if invitation == nil || invitation.GroupID != id {
+		return ErrGroupInvitationNotFound
+	}
+
+	return s.groupRepo.RevokeInvitation(ctx, invitationID)
+}
+
+// AcceptInvitation adds the user's volunteer profile to the group the token
+// invites them to.
+func (s *GroupService) AcceptInvitation(ctx context.Context, input *models.AcceptGroupInvitationInput, userID int64) (*models.VolunteerGroup, error) {
+	volunteer, err := s.currentVolunteer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := s.groupRepo.GetInvitationByTokenHash(ctx, hashInvitationToken(strings.TrimSpace(input.Token)))
+	if err != nil {
+		return nil, err
+	}
+	if invitation == nil {
+		return nil, ErrGroupInvitationNotFound
+	}
+	if invitation.Status != models.GroupInvitationPending || time.Now().After(invitation.ExpiresAt) {
+		return nil, ErrGroupInvitationInvalid
+	}
+
+	accepted, err := s.groupRepo.AcceptInvitation(ctx, invitation, volunteer.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !accepted {
+		return nil, ErrGroupInvitationInvalid
+	}
+
+	return s.getGroup(ctx, invitation.GroupID)
+}
+
+// SetMemberRole promotes a member to leader or demotes a leader. The last
+// leader cannot be demoted.
+func (s *GroupService) SetMemberRole(ctx context.Context, id, volunteerID int64, input *models.UpdateGroupMemberInput, userID int64) error {
+	if _, err := s.checkLeader(ctx, id, userID); err != nil {
+		return err
+	}
+
+	member, err := s.groupRepo.GetMember(ctx, id, volunteerID)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return ErrGroupMemberNotFound
+	}
+	if member.Role == models.GroupRoleLeader && input.Role != models.GroupRoleLeader {
+		if err := s.checkNotLastLeader(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return s.groupRepo.SetMemberRole(ctx, id, volunteerID, input.Role)
+}
+
+// RemoveMember lets a leader remove a member or a member leave the group.
+func (s *GroupService) RemoveMember(ctx context.Context, id, volunteerID, userID int64) error {
+	current, err := s.checkMember(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if current.VolunteerID != volunteerID && current.Role != models.GroupRoleLeader {
+		return ErrNotGroupLeader
+	}
+
+	member, err := s.groupRepo.GetMember(ctx, id, volunteerID)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return ErrGroupMemberNotFound
+	}
+	if member.Role == models.GroupRoleLeader {
+		if err := s.checkNotLastLeader(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return s.groupRepo.RemoveMember(ctx, id, volunteerID)
+}
+
+// SignUp signs group members up for an event on a leader's behalf. Each
+// member goes through the same checks as an individual signup. In
+// all-or-nothing mode any ineligible member, or too few seats, fails the whole
+// request; in partial mode those members are skipped and reported.
+func (s *GroupService) SignUp(ctx context.Context, id int64, input *models.CreateGroupSignupInput, userID int64) (*models.GroupSignup, error) {
+	leader, err := s.checkLeader(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventService.GetByID(ctx, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.Status != models.EventStatusActive {
+		return nil, ErrEventNotOpen
+	}
+
+	if input.HostOrganizationID != nil {
+		isHost, err := isEventHost(ctx, s.registrationService.cohostRepo, event, *input.HostOrganizationID)
+		if err != nil {
+			return nil, err
+		}
+		if !isHost {
+			return nil, ErrInvalidEventHost
+		}
+	}
+
+	// A leader cannot answer registration questions for each member
+	form, err := s.registrationService.formRepo.GetCurrent(ctx, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+	var formID *int64
+	if form != nil {
+		if err := validateAnswers(form.Questions, nil); err != nil {
+			return nil, ErrGroupSignupFormRequired
+		}
+		formID = &form.ID
+	}
+
+	memberIDs, err := s.requestedMembers(ctx, id, input.MemberIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*models.GroupSignupMember, 0, len(memberIDs))
+	ineligible := make(map[string]string)
+	for _, volunteerID := range memberIDs {
+		member := &models.GroupSignupMember{VolunteerID: volunteerID, Status: models.GroupSignupMemberSignedUp}
+		if reason, err := s.ineligibleReason(ctx, event, volunteerID, input.AllowConflicts); err != nil {
+			return nil, err
+		} else if reason != "" {
+			member.Status = models.GroupSignupMemberSkipped
+			member.Reason = reason
+			ineligible[strconv.FormatInt(volunteerID, 10)] = reason
+		}
+		members = append(members, member)
+	}
+	if input.Mode == models.GroupSignupAllOrNothing && len(ineligible) > 0 {
+		return nil, &GroupSignupIneligibleError{Members: ineligible}
+	}
+
+	status := models.RegistrationStatusRegistered
+	if event.SignupApproval == models.SignupApprovalManual {
+		status = models.RegistrationStatusPending
+	}
+	now := time.Now()
+	template := &models.EventRegistration{
+		EventID:            event.ID,
+		Status:             status,
+		HostOrganizationID: input.HostOrganizationID,
+		FormID:             formID,
+		RegistrationDate:   now,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+
+	groupSignup := &models.GroupSignup{
+		GroupID:            id,
+		EventID:            event.ID,
+		Mode:               input.Mode,
+		HostOrganizationID: input.HostOrganizationID,
+		RequestedCount:     len(memberIDs),
+		CreatedBy:          leader.VolunteerID,
+	}
+	seatsLeft, err := s.groupRepo.CreateSignup(ctx, groupSignup, members, template)
+	if err != nil {
+		return nil, err
+	}
+	if groupSignup.ID == 0 {
+		return nil, &GroupCapacityError{Requested: len(memberIDs), SeatsLeft: seatsLeft}
+	}
+	groupSignup.Members = members
+
+	s.announceGroupSignup(ctx, groupSignup, event)
+
+	return groupSignup, nil
+}
+
+func (s *GroupService) ListSignups(ctx context.Context, id, userID int64) ([]*models.GroupSignup, error) {
+	if _, err := s.checkMember(ctx, id, userID); err != nil {
+		return nil, err
+	}
+
+	return s.groupRepo.ListSignups(ctx, id)
+}
+
+// GetSignup returns a group signup with each member's outcome to group
+// members, the event's hosts and admins.
+func (s *GroupService) GetSignup(ctx context.Context, id, userID int64, role string) (*models.GroupSignup, error) {
+	groupSignup, err := s.getSignup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch models.Role(role) {
+	case models.RoleAdmin:
+	case models.RoleOrganization:
+		event, err := s.eventService.GetByID(ctx, groupSignup.EventID)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := s.eventService.checkEventEditor(ctx, event, userID); err != nil {
+			return nil, err
+		}
+	default:
+		if _, err := s.checkMember(ctx, groupSignup.GroupID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	return groupSignup, nil
+}
+
+// RecordAttendance lets an event host mark each signed-up member attended or
+// no-show with the hours they worked.
+func (s *GroupService) RecordAttendance(ctx context.Context, id int64, input *models.RecordGroupAttendanceInput, userID int64) (*models.GroupSignup, error) {
+	groupSignup, err := s.getSignup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventService.GetByID(ctx, groupSignup.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.eventService.checkEventEditor(ctx, event, userID); err != nil {
+		return nil, err
+	}
+
+	signedUp := make(map[int64]bool, len(groupSignup.Members))
+	for _, member := range groupSignup.Members {
+		if member.Status == models.GroupSignupMemberSignedUp {
+			signedUp[member.VolunteerID] = true
+		}
+	}
+	problems := make(map[string]string)
+	for _, entry := range input.Members {
+		if !signedUp[entry.VolunteerID] {
+			problems[strconv.FormatInt(entry.VolunteerID, 10)] = "not signed up through this group signup"
+			continue
+		}
+		// Attendance is only recorded for members holding a seat
+		registration, err := s.registrationService.eventRegRepo.GetByEventAndVolunteer(ctx, event.ID, entry.VolunteerID)
+		if err != nil {
+			return nil, err
+		}
+		if registration == nil || !registration.HoldsSeat() {
+			problems[strconv.FormatInt(entry.VolunteerID, 10)] = "registration is not confirmed"
+		}
+	}
+	if len(problems) > 0 {
+		return nil, &FormValidationError{Fields: problems}
+	}
+
+	if err := s.groupRepo.RecordAttendance(ctx, groupSignup, input.Members, userID); err != nil {
+		return nil, err
+	}
+	for _, entry := range input.Members {
+		if err := s.registrationService.cacheService.DeleteVolunteer(ctx, entry.VolunteerID); err != nil {
+			// logger.Error("Failed to invalidate volunteer cache", err)
+		}
+		if entry.Status == models.RegistrationStatusAttended {
+			if _, err := s.badgeService.Evaluate(ctx, entry.VolunteerID); err != nil {
+				// logger.Error("Failed to evaluate badges", err)
+			}
+			if err := s.progressionService.Update(ctx, entry.VolunteerID); err != nil {
+				// logger.Error("Failed to update volunteer level", err)
+			}
+		}
+	}
+
+	return s.getSignup(ctx, id)
+}
+
+// ineligibleReason runs the individual signup checks for one member and
+// explains why they cannot be signed up, or returns "" when they can.
+func (s *GroupService) ineligibleReason(ctx context.Context, event *models.Event, volunteerID int64, allowConflicts bool) (string, error) {
+	existing, err := s.registrationService.eventRegRepo.GetByEventAndVolunteer(ctx, event.ID, volunteerID)
+	if err != nil {
+		return "", err
+	}
+	if existing != nil && !existing.CanReopen() {
+		return ErrAlreadyRegistered.Error(), nil
+	}
+
+	if _, err := pendingWaiverSignature(ctx, s.registrationService.waiverRepo, event, &models.CreateEventRegistrationInput{VolunteerID: volunteerID}); err != nil {
+		if err == ErrWaiverSignatureRequired {
+			return "must sign the event's waiver first", nil
+		}
+		return "", err
+	}
+
+	if err := s.registrationService.verificationService.CheckSignupLevel(ctx, event, volunteerID); err != nil {
+		var levelErr *VerificationLevelTooLowError
+		if errors.As(err, &levelErr) {
+			return levelErr.Error(), nil
+		}
+		return "", err
+	}
+
+	requireApproval, err := s.registrationService.reliabilityService.CheckSignupPolicy(ctx, event, volunteerID)
+	if err != nil {
+		var tooLowErr *ReliabilityTooLowError
+		if errors.As(err, &tooLowErr) {
+			return tooLowErr.Error(), nil
+		}
+		return "", err
+	}
+	// Under manual approval every member already waits for a coordinator
+	if requireApproval && event.SignupApproval != models.SignupApprovalManual {
+		return "needs the organization's approval to sign up; sign up individually", nil
+	}
+
+	if err := s.registrationService.checkScheduleConflicts(ctx, volunteerID, event, allowConflicts); err != nil {
+		var conflictErr *ScheduleConflictError
+		if errors.As(err, &conflictErr) {
+			return conflictErr.Error(), nil
+		}
+		return "", err
+	}
+
+	return "", nil
+}
+
+// requestedMembers resolves the member IDs of a group signup, defaulting to
+// every member of the group.
+func (s *GroupService) requestedMembers(ctx context.Context, groupID int64, requested []int64) ([]int64, error) {
+	members, err := s.groupRepo.ListMembers(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	isMember := make(map[int64]bool, len(members))
+	for _, member := range members {
+		isMember[member.VolunteerID] = true
+	}
+
+	var ids []int64
+	if len(requested) == 0 {
+		for _, member := range members {
+			ids = append(ids, member.VolunteerID)
+		}
+	} else {
+		seen := make(map[int64]bool, len(requested))
+		problems := make(map[string]string)
+		for _, id := range requested {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			if !isMember[id] {
+				problems[strconv.FormatInt(id, 10)] = ErrGroupMemberNotFound.Error()
+				continue
+			}
+			ids = append(ids, id)
+		}
+		if len(problems) > 0 {
+			return nil, &FormValidationError{Fields: problems}
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, ErrGroupSignupNoMembers
+	}
+	return ids, nil
+}
+
+// announceGroupSignup refreshes the registration caches and tells each member
+// they were signed up.
+func (s *GroupService) announceGroupSignup(ctx context.Context, groupSignup *models.GroupSignup, event *models.Event) {
+	if err := s.registrationService.cacheService.cache.Delete(ctx, cache.RegistrationsByEventKey(event.ID)); err != nil {
+		// logger.Error("Failed to invalidate event registrations cache", err)
+	}
+
+	for _, member := range groupSignup.Members {
+		if member.Status != models.GroupSignupMemberSignedUp {
+			continue
+		}
+		s.registrationService.invalidateRelatedCaches(ctx, event.ID, member.VolunteerID)
+
+		notification := &models.CreateNotificationInput{
+			Type:    models.NotificationGroupSignup,
+			Title:   fmt.Sprintf("Your group signed you up for %s", event.Title),
+			Message: "A group leader signed you up. Cancel your signup if you can't make it.",
+			EventID: &event.ID,
+		}
+		if _, err := s.notificationService.NotifyVolunteer(ctx, member.VolunteerID, notification); err != nil {
+			// Log error but don't fail the operation
+			// logger.Error("Failed to notify group member", err)
+		}
+	}
+
+	// Anyone can subscribe to the event's topic, so it only gets the counts,
+	// not each member's outcome
+	summary := *groupSignup
+	summary.Members = nil
+	s.registrationService.wsManager.PublishToTopic(fmt.Sprintf("event:%d", event.ID), &summary)
+}
+
+func (s *GroupService) currentVolunteer(ctx context.Context, userID int64) (*models.Volunteer, error) {
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+	return volunteer, nil
+}
+
+func (s *GroupService) getGroup(ctx context.Context, id int64) (*models.VolunteerGroup, error) {
+	group, err := s.groupRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, ErrGroupNotFound
+	}
+	return group, nil
+}
+
+func (s *GroupService) getSignup(ctx context.Context, id int64) (*models.GroupSignup, error) {
+	groupSignup, err := s.groupRepo.GetSignup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if groupSignup == nil {
+		return nil, ErrGroupSignupNotFound
+	}
+	return groupSignup, nil
+}
+
+// checkMember returns the user's membership of the group.
+func (s *GroupService) checkMember(ctx context.Context, groupID, userID int64) (*models.GroupMember, error) {
+	if _, err := s.getGroup(ctx, groupID); err != nil {
+		return nil, err
+	}
+
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrUnauthorized
+	}
+
+	member, err := s.groupRepo.GetMember(ctx, groupID, volunteer.ID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, ErrUnauthorized
+	}
+	return member, nil
+}
+
+// checkLeader returns the user's membership of the group if they lead it.
+func (s *GroupService) checkLeader(ctx context.Context, groupID, userID int64) (*models.GroupMember, error) {
+	member, err := s.checkMember(ctx, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member.Role != models.GroupRoleLeader {
+		return nil, ErrNotGroupLeader
+	}
+	return member, nil
+}
+
+func (s *GroupService) checkNotLastLeader(ctx context.Context, groupID int64) error {
+	leaders, err := s.groupRepo.CountLeaders(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if leaders <= 1 {
+		return ErrLastGroupLeader
+	}
+	return nil
+}
+
+func newInvitationToken() (string, error) {
+	random := make([]byte, 24)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(random), nil
+}
+
+func hashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+)
+
+var (
+	ErrHoursNotFound       = errors.New("hours submission not found")
+	ErrHoursAlreadyDecided = errors.New("these hours were already reviewed; dispute the decision instead")
+	ErrHoursNotReviewable  = errors.New("only submitted or disputed hours can be reviewed")
+	ErrHoursNotDisputable  = errors.New("only adjusted or rejected hours can be disputed")
+	ErrHoursBeforeEvent    = errors.New("hours can be submitted once the event has started")
+	ErrHoursExceedEvent    = errors.New("hours cannot exceed the length of the event")
+)
+
+// reviewQueueStatuses are the statuses waiting on the host.
+var reviewQueueStatuses = []models.HoursStatus{models.HoursStatusSubmitted, models.HoursStatusDisputed}
+
+type HoursService struct {
+	hoursRepo           repository.HoursRepository
+	feedbackRepo        repository.FeedbackRepository
+	eventService        *EventService
+	organizationRepo    repository.OrganizationRepository
+	volunteerRepo       VolunteerRepository
+	notificationService *NotificationService
+	volunteerService    *VolunteerService
+	badgeService        *BadgeService
+	progressionService  *ProgressionService
+}
+
+func NewHoursService(
+	hoursRepo repository.HoursRepository,
+	feedbackRepo repository.FeedbackRepository,
+	eventService *EventService,
+	organizationRepo repository.OrganizationRepository,
+	volunteerRepo VolunteerRepository,
+	notificationService *NotificationService,
+	volunteerService *VolunteerService,
+	badgeService *BadgeService,
+	progressionService *ProgressionService) *HoursService {
+	return &HoursService{
+		hoursRepo:           hoursRepo,
+		feedbackRepo:        feedbackRepo,
+		eventService:        eventService,
+		organizationRepo:    organizationRepo,
+		volunteerRepo:       volunteerRepo,
+		notificationService: notificationService,
+		volunteerService:    volunteerService,
+		badgeService:        badgeService,
+		progressionService:  progressionService,
+	}
+}
+
+// Submit records the hours a volunteer worked at an event for review by the
+// host that recruited them. Pending or rejected hours may be resubmitted.
+func (s *HoursService) Submit(ctx context.Context, eventID int64, input *models.SubmitHoursInput, userID int64) (*models.HourSubmission, error) {
+	volunteer, err := s.currentVolunteer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventService.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if time.Now().Before(event.StartTime) {
+		return nil, ErrHoursBeforeEvent
+	}
+	if event.EndTime.After(event.StartTime) && input.Hours > event.EndTime.Sub(event.StartTime).Hours() {
+		return nil, ErrHoursExceedEvent
+	}
+
+	participant, err := s.feedbackRepo.GetParticipant(ctx, eventID, volunteer.ID)
+	if err != nil {
+		return nil, err
+	}
+	if participant == nil {
+		return nil, ErrNotEventParticipant
+	}
+
+	note := strings.TrimSpace(input.Note)
+	change := &models.HoursChange{
+		Action:  models.HoursActionSubmitted,
+		Hours:   &input.Hours,
+		Comment: note,
+		ActorID: userID,
+	}
+
+	existing, err := s.hoursRepo.GetByEventAndVolunteer(ctx, eventID, volunteer.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		if existing.Status != models.HoursStatusSubmitted && existing.Status != models.HoursStatusRejected {
+			return nil, ErrHoursAlreadyDecided
+		}
+		existing.Hours = input.Hours
+		existing.Note = note
+		existing.Status = models.HoursStatusSubmitted
+		if err := s.hoursRepo.Update(ctx, existing, change); err != nil {
+			return nil, err
+		}
+		return s.GetByID(ctx, existing.ID, userID, string(models.RoleVolunteer))
+	}
+
+	organizationID := event.OrganizationID
+	if participant.HostOrganizationID != nil {
+		organizationID = *participant.HostOrganizationID
+	}
+	submission := &models.HourSubmission{
+		EventID:        eventID,
+		VolunteerID:    volunteer.ID,
+		OrganizationID: organizationID,
+		Hours:          input.Hours,
+		Status:         models.HoursStatusSubmitted,
+		Note:           note,
+	}
+	if err := s.hoursRepo.Create(ctx, submission, change); err != nil {
+		return nil, err
+	}
+	return s.GetByID(ctx, submission.ID, userID, string(models.RoleVolunteer))
+}
+
+// GetByID returns a submission and its history to the volunteer, the
+// credited organization or an admin.
+func (s *HoursService) GetByID(ctx context.Context, id, userID int64, role string) (*models.HourSubmission, error) {
+	submission, err := s.hoursRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if submission == nil {
+		return nil, ErrHoursNotFound
+	}
+
+	switch models.Role(role) {
+	case models.RoleAdmin:
+	case models.RoleOrganization:
+		if _, err := s.checkReviewer(ctx, submission, userID); err != nil {
+			return nil, err
+		}
+	default:
+		volunteer, err := s.currentVolunteer(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if submission.VolunteerID != volunteer.ID {
+			return nil, ErrUnauthorized
+		}
+	}
+	return submission, nil
+}
+
+func (s *HoursService) ListMine(ctx context.Context, userID int64, offset, limit int) ([]*models.HourSubmission, error) {
+	volunteer, err := s.currentVolunteer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.hoursRepo.ListByVolunteer(ctx, volunteer.ID, offset, limit)
+}
+
+// ListByEvent lists the hours credited to the caller's organization for one
+// of the events it hosts.
+func (s *HoursService) ListByEvent(ctx context.Context, eventID, userID int64, offset, limit int) ([]*models.HourSubmission, error) {
+	event, err := s.eventService.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	org, err := s.eventService.checkEventEditor(ctx, event, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.hoursRepo.ListByEvent(ctx, eventID, org.ID, offset, limit)
+}
+
+// ListReviewQueue returns the submitted and disputed hours waiting on the
+// caller's organization.
+func (s *HoursService) ListReviewQueue(ctx context.Context, userID int64, offset, limit int) ([]*models.HourSubmission, error) {
+	org, err := s.organizationRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, ErrOrganizationNotFound
+	}
+	return s.hoursRepo.ListByOrganizationAndStatus(ctx, org.ID, reviewQueueStatuses, offset, limit)
+}
+
+// Review approves, adjusts or rejects submitted or disputed hours. Adjusting
+// and rejecting require a reason, which is shown to the volunteer.
+func (s *HoursService) Review(ctx context.Context, id int64, input *models.ReviewHoursInput, userID int64) (*models.HourSubmission, error) {
+	submission, err := s.hoursRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if submission == nil {
+		return nil, ErrHoursNotFound
+	}
+	if _, err := s.checkReviewer(ctx, submission, userID); err != nil {
+		return nil, err
+	}
+	if submission.Status != models.HoursStatusSubmitted && submission.Status != models.HoursStatusDisputed {
+		return nil, ErrHoursNotReviewable
+	}
+
+	reason := strings.TrimSpace(input.Reason)
+	problems := make(map[string]string)
+	if input.Action != "approve" && reason == "" {
+		problems["reason"] = "is required to " + input.Action + " hours"
+	}
+	if input.Action == "adjust" && input.Hours == nil {
+		problems["hours"] = "is required to adjust hours"
+	}
+	if len(problems) > 0 {
+		return nil, &FormValidationError{Fields: problems}
+	}
+
+	var action models.HoursAction
+	switch input.Action {
+	case "approve":
+		action = models.HoursActionApproved
+		submission.Status = models.HoursStatusApproved
+		submission.ApprovedHours = &submission.Hours
+	case "adjust":
+		action = models.HoursActionAdjusted
+		submission.Status = models.HoursStatusAdjusted
+		submission.ApprovedHours = input.Hours
+	default:
+		action = models.HoursActionRejected
+		submission.Status = models.HoursStatusRejected
+		submission.ApprovedHours = nil
+	}
+	now := time.Now()
+	submission.ReviewReason = reason
+	submission.ReviewedBy = &userID
+	submission.ReviewedAt = &now
+
+	if err := s.hoursRepo.Update(ctx, submission, &models.HoursChange{
+		Action:  action,
+		Hours:   submission.ApprovedHours,
+		Comment: reason,
+		ActorID: userID,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.volunteerService.cacheService.DeleteVolunteer(ctx, submission.VolunteerID); err != nil {
+		// logger.Error("Failed to invalidate volunteer cache", err)
+	}
+	if submission.Status != models.HoursStatusRejected {
+		if _, err := s.badgeService.Evaluate(ctx, submission.VolunteerID); err != nil {
+			// logger.Error("Failed to evaluate badges", err)
+		}
+		if err := s.progressionService.Update(ctx, submission.VolunteerID); err != nil {
+			// logger.Error("Failed to update volunteer level", err)
+		}
+	}
+
+	notification := &models.CreateNotificationInput{
+		Type:    models.NotificationHoursReviewed,
+		Title:   fmt.Sprintf("Your hours for %s were %s", submission.EventTitle, submission.Status),
+		Message: reason,
+		EventID: &submission.EventID,
+	}
+	if _, err := s.notificationService.NotifyVolunteer(ctx, submission.VolunteerID, notification); err != nil {
+		// Log error but don't fail the operation
+		// logger.Error("Failed to notify volunteer of hours review", err)
+	}
+
+	return s.hoursRepo.GetByID(ctx, id)
+}
+
+// Dispute lets the volunteer contest an adjustment or rejection, sending the
+// submission back to the host's review queue. Adjusted hours keep counting
+// until the host decides again.
+func (s *HoursService) Dispute(ctx context.Context, id int64, input *models.DisputeHoursInput, userID int64) (*models.HourSubmission, error) {
+	submission, err := s.GetByID(ctx, id, userID, string(models.RoleVolunteer))
+	if err != nil {
+		return nil, err
+	}
+	if submission.Status != models.HoursStatusAdjusted && submission.Status != models.HoursStatusRejected {
+		return nil, ErrHoursNotDisputable
+	}
+
+	reason := strings.TrimSpace(input.Reason)
+	submission.Status = models.HoursStatusDisputed
+	submission.DisputeReason = reason
+	if err := s.hoursRepo.Update(ctx, submission, &models.HoursChange{
+		Action:  models.HoursActionDisputed,
+		Comment: reason,
+		ActorID: userID,
+	}); err != nil {
+		return nil, err
+	}
+
+	org, err := s.organizationRepo.GetByID(ctx, submission.OrganizationID)
+	if err == nil && org != nil {
+		notification := &models.CreateNotificationInput{
+			UserID:  org.UserID,
+			Type:    models.NotificationHoursDisputed,
+			Title:   fmt.Sprintf("%s disputed their hours for %s", submission.VolunteerName, submission.EventTitle),
+			Message: reason,
+			EventID: &submission.EventID,
+		}
+		if _, err := s.notificationService.Notify(ctx, notification); err != nil {
+			// Log error but don't fail the operation
+			// logger.Error("Failed to notify organization of hours dispute", err)
+		}
+	}
+
+	return s.hoursRepo.GetByID(ctx, id)
+}
+
+// checkReviewer ensures the user belongs to the organization credited with
+// the hours, which is the one that reviews them.
+func (s *HoursService) checkReviewer(ctx context.Context, submission *models.HourSubmission, userID int64) (*models.Organization, error) {
+	org, err := s.organizationRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil || org.ID != submission.OrganizationID {
+		return nil, ErrUnauthorized
+	}
+	return org, nil
+}
+
+func (s *HoursService) currentVolunteer(ctx context.Context, userID int64) (*models.Volunteer, error) {
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+	return volunteer, nil
+}
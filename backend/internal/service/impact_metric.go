@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+)
+
+var (
+	ErrImpactMetricNotFound = errors.New("impact metric not found")
+	ErrImpactMetricExists   = errors.New("an impact metric with this key already exists")
+	ErrInvalidMetricKey     = errors.New("metric keys may only contain lowercase letters, digits and underscores")
+)
+
+var metricKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+type ImpactMetricService struct {
+	metricRepo   repository.ImpactMetricRepository
+	eventService *EventService
+}
+
+func NewImpactMetricService(metricRepo repository.ImpactMetricRepository, eventService *EventService) *ImpactMetricService {
+	return &ImpactMetricService{
+		metricRepo:   metricRepo,
+		eventService: eventService,
+	}
+}
+
+func (s *ImpactMetricService) Create(ctx context.Context, input *models.CreateImpactMetricInput) (*models.ImpactMetricDefinition, error) {
+	if !metricKeyPattern.MatchString(input.Key) {
+		return nil, ErrInvalidMetricKey
+	}
+
+	existing, err := s.metricRepo.GetByKey(ctx, input.Key)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrImpactMetricExists
+	}
+
+	return s.metricRepo.Create(ctx, input)
+}
+
+func (s *ImpactMetricService) Update(ctx context.Context, id int64, input *models.UpdateImpactMetricInput) (*models.ImpactMetricDefinition, error) {
+	metric, err := s.metricRepo.Update(ctx, id, input)
+	if err != nil {
+		return nil, err
+	}
+	if metric == nil {
+		return nil, ErrImpactMetricNotFound
+	}
+	return metric, nil
+}
+
+// List returns the active metric definitions, limited to those that apply to
+// the category when one is given.
+func (s *ImpactMetricService) List(ctx context.Context, category string, includeInactive bool) ([]*models.ImpactMetricDefinition, error) {
+	metrics, err := s.metricRepo.List(ctx, !includeInactive)
+	if err != nil {
+		return nil, err
+	}
+	if category == "" {
+		return metrics, nil
+	}
+
+	var applicable []*models.ImpactMetricDefinition
+	for _, metric := range metrics {
+		if metricApplies(metric, category) {
+			applicable = append(applicable, metric)
+		}
+	}
+	return applicable, nil
+}
+
+func (s *ImpactMetricService) ListEventValues(ctx context.Context, eventID int64) ([]*models.EventMetricValue, error) {
+	if _, err := s.eventService.GetByID(ctx, eventID); err != nil {
+		return nil, err
+	}
+
+	return s.metricRepo.ListEventValues(ctx, eventID)
+}
+
+// RecordEventValues stores the values an event's host reports. Every key must
+// name an active metric that applies to the event's category.
+func (s *ImpactMetricService) RecordEventValues(ctx context.Context, eventID int64, input *models.RecordEventMetricsInput, userID int64) ([]*models.EventMetricValue, error) {
+	event, err := s.eventService.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.eventService.checkEventEditor(ctx, event, userID); err != nil {
+		return nil, err
+	}
+
+	metrics, err := s.metricRepo.List(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	byKey := make(map[string]*models.ImpactMetricDefinition, len(metrics))
+	for _, metric := range metrics {
+		byKey[metric.Key] = metric
+	}
+
+	problems := make(map[string]string)
+	values := make(map[int64]float64)
+	var remove []int64
+	for key, value := range input.Values {
+		metric, ok := byKey[key]
+		switch {
+		case !ok:
+			problems[key] = "unknown or inactive metric"
+		case !metricApplies(metric, event.Category):
+			problems[key] = "metric does not apply to this event's category"
+		case value == nil:
+			remove = append(remove, metric.ID)
+		case *value < 0:
+			problems[key] = "value cannot be negative"
+		default:
+			values[metric.ID] = *value
+		}
+	}
+	if len(problems) > 0 {
+		return nil, &FormValidationError{Fields: problems}
+	}
+
+	if err := s.metricRepo.SetEventValues(ctx, eventID, values, remove, userID); err != nil {
+		return nil, err
+	}
+
+	return s.metricRepo.ListEventValues(ctx, eventID)
+}
+
+// metricApplies reports whether the metric can be recorded for events in the category.
+func metricApplies(metric *models.ImpactMetricDefinition, category string) bool {
+	if len(metric.Categories) == 0 {
+		return true
+	}
+	for _, c := range metric.Categories {
+		if strings.EqualFold(strings.TrimSpace(c), strings.TrimSpace(category)) {
+			return true
+		}
+	}
+	return false
+}
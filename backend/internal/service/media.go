@@ -0,0 +1,267 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+	"volunteer-management/internal/config"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+	"volunteer-management/pkg/imaging"
+	"volunteer-management/pkg/storage"
+)
+
+// gcBatchSize is how many orphaned uploads are removed per collection pass.
+const gcBatchSize = 100
+
+var (
+	ErrMediaNotFound        = errors.New("media not found")
+	ErrMediaTooLarge        = errors.New("file exceeds the maximum upload size")
+	ErrImageTooLarge        = errors.New("image dimensions exceed the maximum")
+	ErrUnsupportedMediaType = errors.New("only JPEG, PNG and GIF images are supported")
+	ErrInvalidMediaVariant  = errors.New("unknown media variant")
+)
+
+// mediaVariantSizes is the longest side, in pixels, of each stored variant.
+var mediaVariantSizes = map[string]int{
+	models.MediaVariantOriginal:  2048,
+	models.MediaVariantMedium:    800,
+	models.MediaVariantThumbnail: 200,
+}
+
+var allowedMediaTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+type MediaService struct {
+	mediaRepo repository.MediaRepository
+	storage   storage.Storage
+	signer    *storage.URLSigner
+	cfg       config.StorageConfig
+}
+
+func NewMediaService(mediaRepo repository.MediaRepository, store storage.Storage, signer *storage.URLSigner, cfg config.StorageConfig) *MediaService {
+	return &MediaService{
+		mediaRepo: mediaRepo,
+		storage:   store,
+		signer:    signer,
+		cfg:       cfg,
+	}
+}
+
+// Upload validates an image, strips its metadata, stores it with resized
+// variants and returns the media record with signed URLs.
+func (s *MediaService) Upload(ctx context.Context, body io.Reader, userID int64) (*models.Media, error) {
+	data, err := io.ReadAll(io.LimitReader(body, s.cfg.MaxUploadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > s.cfg.MaxUploadSize {
+		return nil, ErrMediaTooLarge
+	}
+
+	// Trust the file contents rather than the client's declared content type
+	if !allowedMediaTypes[http.DetectContentType(data)] {
+		return nil, ErrUnsupportedMediaType
+	}
+
+	img, err := imaging.Decode(data, s.cfg.MaxPixels)
+	if err == imaging.ErrTooManyPixels {
+		return nil, ErrImageTooLarge
+	}
+	if err != nil {
+		return nil, ErrUnsupportedMediaType
+	}
+
+	prefix, err := newMediaPrefix()
+	if err != nil {
+		return nil, err
+	}
+
+	media := &models.Media{
+		UserID:   userID,
+		Variants: make(map[string]models.MediaVariant),
+	}
+	for name, size := range mediaVariantSizes {
+		variant, err := s.storeVariant(ctx, prefix, name, imaging.Fit(img, size))
+		if err != nil {
+			s.deleteVariants(ctx, media)
+			return nil, err
+		}
+		media.Variants[name] = *variant
+	}
+
+	original := media.Variants[models.MediaVariantOriginal]
+	media.ContentType = original.ContentType
+	media.Size = original.Size
+	media.Width = original.Width
+	media.Height = original.Height
+
+	if err := s.mediaRepo.Create(ctx, media); err != nil {
+		s.deleteVariants(ctx, media)
+		return nil, err
+	}
+
+	if err := s.decorate(media); err != nil {
+		return nil, err
+	}
+	return media, nil
+}
+
+func (s *MediaService) GetByID(ctx context.Context, id int64) (*models.Media, error) {
+	media, err := s.mediaRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if media == nil {
+		return nil, ErrMediaNotFound
+	}
+
+	if err := s.decorate(media); err != nil {
+		return nil, err
+	}
+	return media, nil
+}
+
+// SignedURL returns a short-lived URL for one variant of the media.
+func (s *MediaService) SignedURL(ctx context.Context, id int64, variant string) (string, error) {
+	media, err := s.mediaRepo.GetByID(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if media == nil {
+		return "", ErrMediaNotFound
+	}
+
+	stored, ok := media.Variants[variant]
+	if !ok {
+		return "", ErrInvalidMediaVariant
+	}
+	return s.storage.SignedURL(stored.Key, s.cfg.URLExpiry)
+}
+
+// OpenSigned verifies a signed file URL issued for local storage and opens
+// the object it points at.
+func (s *MediaService) OpenSigned(ctx context.Context, key string, expires int64, signature string) (io.ReadCloser, error) {
+	if err := s.signer.Verify(key, expires, signature); err != nil {
+		return nil, err
+	}
+
+	file, err := s.storage.Get(ctx, key)
+	if err == storage.ErrNotFound {
+		return nil, ErrMediaNotFound
+	}
+	return file, err
+}
+
+// CollectGarbage removes uploads that nothing has referenced within the
+// configured grace period and returns how many were removed.
+func (s *MediaService) CollectGarbage(ctx context.Context) (int, error) {
+	orphans, err := s.mediaRepo.ListOrphaned(ctx, time.Now().Add(-s.cfg.OrphanTTL), gcBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, media := range orphans {
+		if err := s.deleteVariants(ctx, media); err != nil {
+			log.Printf("Failed to delete files of media %d: %v", media.ID, err)
+			continue
+		}
+		if err := s.mediaRepo.Delete(ctx, media.ID); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+
+	return removed, nil
+}
+
+// RunGarbageCollector calls CollectGarbage on the configured interval until
+// the context is cancelled.
+func (s *MediaService) RunGarbageCollector(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.GCInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			removed, err := s.CollectGarbage(ctx)
+			if err != nil {
+				log.Printf("Media garbage collection failed: %v", err)
+			} else if removed > 0 {
+				log.Printf("Removed %d orphaned media uploads", removed)
+			}
+		}
+	}
+}
+
+func (s *MediaService) storeVariant(ctx context.Context, prefix, name string, img *imaging.Image) (*models.MediaVariant, error) {
+	data, contentType, err := imaging.Encode(img)
+	if err != nil {
+		return nil, err
+	}
+
+	extension := ".png"
+	if contentType == "image/jpeg" {
+		extension = ".jpg"
+	}
+	key := prefix + "/" + name + extension
+
+	if err := s.storage.Put(ctx, key, bytes.NewReader(data), int64(len(data)), contentType); err != nil {
+		return nil, err
+	}
+
+	bounds := img.Bounds()
+	return &models.MediaVariant{
+		Key:         key,
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		Width:       bounds.Dx(),
+		Height:      bounds.Dy(),
+	}, nil
+}
+
+func (s *MediaService) deleteVariants(ctx context.Context, media *models.Media) error {
+	var firstErr error
+	for _, variant := range media.Variants {
+		if err := s.storage.Delete(ctx, variant.Key); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// decorate fills in the stable path and signed URLs of every variant.
+func (s *MediaService) decorate(media *models.Media) error {
+	media.Path = fmt.Sprintf("/media/%d", media.ID)
+	media.URLs = make(map[string]string, len(media.Variants))
+	for name, variant := range media.Variants {
+		url, err := s.storage.SignedURL(variant.Key, s.cfg.URLExpiry)
+		if err != nil {
+			return err
+		}
+		media.URLs[name] = url
+	}
+	return nil
+}
+
+// newMediaPrefix returns a unique, unguessable key prefix for an upload.
+func newMediaPrefix() (string, error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return time.Now().Format("media/2006/01/") + hex.EncodeToString(random), nil
+}
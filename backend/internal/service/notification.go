@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+	"volunteer-management/internal/websocket"
+)
+
+var (
+	ErrNotificationNotFound = errors.New("notification not found")
+)
+
+type NotificationService struct {
+	notificationRepo repository.NotificationRepository
+	volunteerRepo    VolunteerRepository
+	wsManager        *websocket.Manager
+}
+
+func NewNotificationService(notificationRepo repository.NotificationRepository, volunteerRepo VolunteerRepository, wsManager *websocket.Manager) *NotificationService {
+	return &NotificationService{
+		notificationRepo: notificationRepo,
+		volunteerRepo:    volunteerRepo,
+		wsManager:        wsManager,
+	}
+}
+
+// Notify stores a notification for the user and pushes it to their websocket topic.
+func (s *NotificationService) Notify(ctx context.Context, input *models.CreateNotificationInput) (*models.Notification, error) {
+	notification, err := s.notificationRepo.Create(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	s.wsManager.PublishToTopic(fmt.Sprintf("user:%d", notification.UserID), notification)
+
+	return notification, nil
+}
+
+// NotifyVolunteer resolves the volunteer's user account and notifies it.
+func (s *NotificationService) NotifyVolunteer(ctx context.Context, volunteerID int64, input *models.CreateNotificationInput) (*models.Notification, error) {
+	volunteer, err := s.volunteerRepo.GetByID(ctx, volunteerID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+
+	input.UserID = volunteer.UserID
+	return s.Notify(ctx, input)
+}
+
+func (s *NotificationService) ListByUser(ctx context.Context, userID int64, unreadOnly bool, offset, limit int) ([]*models.Notification, error) {
+	return s.notificationRepo.ListByUser(ctx, userID, unreadOnly, offset, limit)
+}
+
+func (s *NotificationService) MarkAsRead(ctx context.Context, id, userID int64) error {
+	notification, err := s.notificationRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if notification == nil {
+		return ErrNotificationNotFound
+	}
+
+	// Users may only acknowledge their own notifications
+	if notification.UserID != userID {
+		return ErrUnauthorized
+	}
+
+	return s.notificationRepo.MarkAsRead(ctx, id)
+}
@@ -113,6 +113,44 @@ func (s *OrganizationService) VerifyOrganization(ctx context.Context, id int64,
 	return s.organizationRepo.VerifyOrganization(ctx, id)
 }
 
+// SetTrusted lets an admin mark an organization as trusted, allowing it to
+// publish events without review.
+func (s *OrganizationService) SetTrusted(ctx context.Context, id int64, trusted bool, adminID int64) error {
+	// Verify organization exists
+	_, err := s.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Check if user is an admin
+	user, err := s.userRepo.GetByID(ctx, adminID)
+	if err != nil {
+		return err
+	}
+	if user == nil || user.Role != models.RoleAdmin {
+		return ErrUnauthorized
+	}
+
+	return s.organizationRepo.SetTrusted(ctx, id, trusted)
+}
+
+// SetSignupPolicy sets the reliability scores the organization requires of
+// volunteers signing up for its events.
+func (s *OrganizationService) SetSignupPolicy(ctx context.Context, id int64, input *models.SetSignupPolicyInput, userID int64) (*models.Organization, error) {
+	organization, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if organization.UserID != userID {
+		return nil, ErrUnauthorized
+	}
+
+	if err := s.organizationRepo.SetSignupPolicy(ctx, id, input); err != nil {
+		return nil, err
+	}
+	return s.GetByID(ctx, id)
+}
+
 func (s *OrganizationService) GetOrganizationProfile(ctx context.Context, userID int64) (*models.Organization, error) {
 	return s.GetByUserID(ctx, userID)
 }
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"sort"
+	"volunteer-management/internal/config"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+	"volunteer-management/internal/websocket"
+)
+
+type ProgressionService struct {
+	progressionRepo     repository.ProgressionRepository
+	volunteerRepo       VolunteerRepository
+	notificationService *NotificationService
+	wsManager           *websocket.Manager
+	cfg                 config.ProgressionConfig
+	rulesVersion        string
+	eventRegRepo        repository.EventRegistrationRepository
+	organizationRepo    repository.OrganizationRepository
+}
+
+func NewProgressionService(
+	progressionRepo repository.ProgressionRepository,
+	volunteerRepo VolunteerRepository,
+	notificationService *NotificationService,
+	wsManager *websocket.Manager,
+	cfg config.ProgressionConfig,
+	eventRegRepo repository.EventRegistrationRepository,
+	organizationRepo repository.OrganizationRepository) *ProgressionService {
+	thresholds := append([]int(nil), cfg.LevelThresholds...)
+	sort.Ints(thresholds)
+	cfg.LevelThresholds = thresholds
+
+	// Stored levels computed under other rules are stale and recomputed
+	// without level-up notifications.
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%g|%g|%g|%v",
+		cfg.XPPerHour, cfg.XPPerEvent, cfg.XPPerStreakMonth, cfg.LevelThresholds)))
+
+	return &ProgressionService{
+		progressionRepo:     progressionRepo,
+		volunteerRepo:       volunteerRepo,
+		notificationService: notificationService,
+		wsManager:           wsManager,
+		cfg:                 cfg,
+		rulesVersion:        hex.EncodeToString(sum[:8]),
+		eventRegRepo:        eventRegRepo,
+		organizationRepo:    organizationRepo,
+	}
+}
+
+// GetProgress returns the volunteer's level, XP and progress toward the next
+// level to the volunteer, admins and organizations the volunteer registered
+// with. It does not store the level; Update does as the volunteer's record
+// changes.
+func (s *ProgressionService) GetProgress(ctx context.Context, volunteerID, userID int64, role string) (*models.VolunteerProgress, error) {
+	volunteer, err := s.volunteerRepo.GetByID(ctx, volunteerID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+	if err := s.checkViewer(ctx, volunteer, userID, role); err != nil {
+		return nil, err
+	}
+
+	return s.current(ctx, volunteerID)
+}
+
+func (s *ProgressionService) GetMine(ctx context.Context, userID int64) (*models.VolunteerProgress, error) {
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+
+	return s.current(ctx, volunteer.ID)
+}
+
+// Update recomputes the volunteer's level after their hours or attendance
+// change, and emits a level-up when they reach a new one.
+func (s *ProgressionService) Update(ctx context.Context, volunteerID int64) error {
+	_, _, err := s.sync(ctx, volunteerID)
+	return err
+}
+
+// Recompute brings every volunteer's stored level up to date, for after the
+// XP rules change. Levels gained only because the rules changed are not
+// announced.
+func (s *ProgressionService) Recompute(ctx context.Context) (*models.RecomputeLevelsResult, error) {
+	result := &models.RecomputeLevelsResult{}
+	for offset := 0; ; offset += backfillPageSize {
+		volunteers, err := s.volunteerRepo.List(ctx, offset, backfillPageSize)
+		if err != nil {
+			return result, err
+		}
+		for _, volunteer := range volunteers {
+			_, changed, err := s.sync(ctx, volunteer.ID)
+			if err != nil {
+				return result, fmt.Errorf("volunteer %d: %w", volunteer.ID, err)
+			}
+			result.Volunteers++
+			if changed {
+				result.Changed++
+			}
+		}
+		if len(volunteers) < backfillPageSize {
+			return result, nil
+		}
+	}
+}
+
+// checkViewer lets the volunteer, admins and organizations the volunteer has
+// registered with see their progress.
+func (s *ProgressionService) checkViewer(ctx context.Context, volunteer *models.Volunteer, userID int64, role string) error {
+	switch models.Role(role) {
+	case models.RoleAdmin:
+		return nil
+	case models.RoleOrganization:
+		org, err := s.organizationRepo.GetByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if org == nil {
+			return ErrUnauthorized
+		}
+		registered, err := s.eventRegRepo.HasRegisteredWith(ctx, volunteer.ID, org.ID)
+		if err != nil {
+			return err
+		}
+		if !registered {
+			return ErrUnauthorized
+		}
+		return nil
+	default:
+		if volunteer.UserID != userID {
+			return ErrUnauthorized
+		}
+		return nil
+	}
+}
+
+// current computes the volunteer's progress without storing it.
+func (s *ProgressionService) current(ctx context.Context, volunteerID int64) (*models.VolunteerProgress, error) {
+	stats, err := s.progressionRepo.GetStats(ctx, volunteerID)
+	if err != nil {
+		return nil, err
+	}
+	return s.progress(volunteerID, stats), nil
+}
+
+// sync computes the volunteer's progress and stores it when it differs from
+// their stored level. Reaching a higher level under the same rules is a
+// level-up; a first computation or a rule change is not.
+func (s *ProgressionService) sync(ctx context.Context, volunteerID int64) (*models.VolunteerProgress, bool, error) {
+	stats, err := s.progressionRepo.GetStats(ctx, volunteerID)
+	if err != nil {
+		return nil, false, err
+	}
+	progress := s.progress(volunteerID, stats)
+
+	stored, err := s.progressionRepo.GetLevel(ctx, volunteerID)
+	if err != nil {
+		return nil, false, err
+	}
+	sameRules := stored != nil && stored.RulesVersion == s.rulesVersion
+	if sameRules && stored.XP == progress.XP && stored.Level == progress.Level {
+		return progress, false, nil
+	}
+
+	if err := s.progressionRepo.SaveLevel(ctx, &models.VolunteerLevel{
+		VolunteerID:  volunteerID,
+		Level:        progress.Level,
+		XP:           progress.XP,
+		RulesVersion: s.rulesVersion,
+	}); err != nil {
+		return nil, false, err
+	}
+
+	if sameRules && progress.Level > stored.Level {
+		s.announceLevelUp(ctx, progress, stored.Level)
+	}
+	return progress, true, nil
+}
+
+func (s *ProgressionService) announceLevelUp(ctx context.Context, progress *models.VolunteerProgress, previousLevel int) {
+	s.wsManager.PublishToTopic(fmt.Sprintf("volunteer:%d", progress.VolunteerID), &models.LevelUp{
+		VolunteerID:   progress.VolunteerID,
+		PreviousLevel: previousLevel,
+		Level:         progress.Level,
+		XP:            progress.XP,
+	})
+
+	notification := &models.CreateNotificationInput{
+		Type:    models.NotificationLevelUp,
+		Title:   fmt.Sprintf("You reached level %d", progress.Level),
+		Message: fmt.Sprintf("You have earned %d XP so far.", progress.XP),
+	}
+	if _, err := s.notificationService.NotifyVolunteer(ctx, progress.VolunteerID, notification); err != nil {
+		// Log error but don't fail the operation
+		// logger.Error("Failed to notify volunteer of level up", err)
+	}
+}
+
+// progress applies the XP rules and level thresholds to the stats.
+func (s *ProgressionService) progress(volunteerID int64, stats *models.ProgressionStats) *models.VolunteerProgress {
+	streak := longestMonthStreak(stats.ActiveMonths)
+	breakdown := models.XPBreakdown{
+		Hours:  int(math.Round(stats.TotalHours * s.cfg.XPPerHour)),
+		Events: int(math.Round(float64(stats.EventsAttended) * s.cfg.XPPerEvent)),
+		Streak: int(math.Round(float64(streak) * s.cfg.XPPerStreakMonth)),
+	}
+
+	progress := &models.VolunteerProgress{
+		VolunteerID:    volunteerID,
+		Level:          1,
+		XP:             breakdown.Hours + breakdown.Events + breakdown.Streak,
+		Breakdown:      breakdown,
+		TotalHours:     stats.TotalHours,
+		EventsAttended: stats.EventsAttended,
+		LongestStreak:  streak,
+	}
+	for _, threshold := range s.cfg.LevelThresholds {
+		if progress.XP < threshold {
+			next := threshold
+			progress.NextLevelXP = &next
+			break
+		}
+		progress.Level++
+		progress.LevelXP = threshold
+	}
+
+	if progress.NextLevelXP == nil {
+		progress.MaxLevel = true
+		progress.Progress = 1
+		return progress
+	}
+	progress.XPToNextLevel = *progress.NextLevelXP - progress.XP
+	progress.Progress = float64(progress.XP-progress.LevelXP) / float64(*progress.NextLevelXP-progress.LevelXP)
+	return progress
+}
@@ -0,0 +1,351 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"volunteer-management/internal/config"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+)
+
+// recommendationCandidates is how many upcoming events are scored per request.
+const recommendationCandidates = 200
+
+type RecommendationService struct {
+	eventRepo        repository.EventRepository
+	eventRegRepo     repository.EventRegistrationRepository
+	volunteerRepo    VolunteerRepository
+	availabilityRepo repository.AvailabilityRepository
+	weights          config.RecommendationConfig
+}
+
+func NewRecommendationService(
+	eventRepo repository.EventRepository,
+	eventRegRepo repository.EventRegistrationRepository,
+	volunteerRepo VolunteerRepository,
+	availabilityRepo repository.AvailabilityRepository,
+	weights config.RecommendationConfig) *RecommendationService {
+	return &RecommendationService{
+		eventRepo:        eventRepo,
+		eventRegRepo:     eventRegRepo,
+		volunteerRepo:    volunteerRepo,
+		availabilityRepo: availabilityRepo,
+		weights:          weights,
+	}
+}
+
+// RecommendForUser scores upcoming events for the volunteer profile of the
+// given user and returns the best matches, highest score first.
+func (s *RecommendationService) RecommendForUser(ctx context.Context, userID int64, limit int) ([]*models.RecommendedEvent, error) {
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+
+	events, err := s.eventRepo.ListUpcoming(ctx, recommendationCandidates)
+	if err != nil {
+		return nil, err
+	}
+
+	// Work out which events the volunteer already holds a spot for and how
+	// often they have attended events of each organization
+	registrations, err := s.eventRegRepo.ListByVolunteer(ctx, volunteer.ID, 0, 1000)
+	if err != nil {
+		return nil, err
+	}
+	registered := make(map[int64]bool)
+	attended := make(map[int64]bool)
+	for _, registration := range registrations {
+		switch registration.Status {
+		case models.RegistrationStatusPending, models.RegistrationStatusRegistered:
+			registered[registration.EventID] = true
+		case models.RegistrationStatusAttended:
+			attended[registration.EventID] = true
+		}
+	}
+
+	pastEvents, err := s.eventRepo.ListByVolunteer(ctx, volunteer.ID, 0, 1000)
+	if err != nil {
+		return nil, err
+	}
+	attendanceByOrg := make(map[int64]int)
+	for _, event := range pastEvents {
+		if attended[event.ID] {
+			attendanceByOrg[event.OrganizationID]++
+		}
+	}
+
+	availability, err := s.availability(ctx, volunteer)
+	if err != nil {
+		return nil, err
+	}
+
+	var recommendations []*models.RecommendedEvent
+	for _, event := range events {
+		if registered[event.ID] || event.VolunteersRegistered >= event.VolunteersNeeded {
+			continue
+		}
+		if availability.BlackedOut(event.StartTime, event.EndTime) {
+			continue
+		}
+
+		// Only events matching at least one signal are recommended; every
+		// match comes with a reason
+		recommendation := s.score(volunteer, availability, event, attendanceByOrg[event.OrganizationID])
+		if len(recommendation.Reasons) > 0 {
+			recommendations = append(recommendations, recommendation)
+		}
+	}
+
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		return recommendations[i].Score > recommendations[j].Score
+	})
+	if len(recommendations) > limit {
+		recommendations = recommendations[:limit]
+	}
+
+	return recommendations, nil
+}
+
+// availability loads the volunteer's weekly windows and blackouts.
+func (s *RecommendationService) availability(ctx context.Context, volunteer *models.Volunteer) (*models.VolunteerAvailability, error) {
+	windows, err := s.availabilityRepo.ListWindows(ctx, volunteer.ID)
+	if err != nil {
+		return nil, err
+	}
+	blackouts, err := s.availabilityRepo.ListBlackouts(ctx, volunteer.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.VolunteerAvailability{
+		VolunteerID: volunteer.ID,
+		TimeZone:    volunteer.TimeZone,
+		Note:        volunteer.Availability,
+		Windows:     windows,
+		Blackouts:   blackouts,
+	}, nil
+}
+
+func (s *RecommendationService) score(volunteer *models.Volunteer, availability *models.VolunteerAvailability, event *models.Event, orgAttendance int) *models.RecommendedEvent {
+	var reasons []string
+	addReason := func(reason string) {
+		if reason != "" {
+			reasons = append(reasons, reason)
+		}
+	}
+
+	var scores models.RecommendationScores
+	var reason string
+	scores.Skills, reason = skillScore(volunteer.Skills, event.RequiredSkills)
+	addReason(reason)
+	scores.Interests, reason = interestScore(volunteer.Interests, event)
+	addReason(reason)
+	scores.Location, reason = locationScore(volunteer.Location, event.Location)
+	addReason(reason)
+	if len(availability.Windows) > 0 {
+		scores.Schedule, reason = windowScore(availability, event)
+	} else {
+		scores.Schedule, reason = scheduleScore(volunteer.Availability, event)
+	}
+	addReason(reason)
+	scores.History, reason = historyScore(orgAttendance, event.OrganizationName)
+	addReason(reason)
+
+	w := s.weights
+	total := w.SkillWeight + w.InterestWeight + w.LocationWeight + w.ScheduleWeight + w.HistoryWeight
+	var score float64
+	if total > 0 {
+		score = (w.SkillWeight*scores.Skills +
+			w.InterestWeight*scores.Interests +
+			w.LocationWeight*scores.Location +
+			w.ScheduleWeight*scores.Schedule +
+			w.HistoryWeight*scores.History) / total
+	}
+
+	return &models.RecommendedEvent{
+		Event:   event,
+		Score:   score,
+		Scores:  scores,
+		Reasons: reasons,
+	}
+}
+
+// skillScore is the share of the event's required skills the volunteer has.
+// Events that need no particular skills get a neutral score, which ranks them
+// but does not on its own make them a match.
+func skillScore(skills, required []string) (float64, string) {
+	if len(required) == 0 {
+		return 0.5, ""
+	}
+
+	have := make(map[string]bool)
+	for _, skill := range skills {
+		have[strings.ToLower(strings.TrimSpace(skill))] = true
+	}
+
+	var matched []string
+	for _, skill := range required {
+		if have[strings.ToLower(strings.TrimSpace(skill))] {
+			matched = append(matched, skill)
+		}
+	}
+	if len(matched) == 0 {
+		return 0, ""
+	}
+
+	return float64(len(matched)) / float64(len(required)),
+		fmt.Sprintf("Uses your skills: %s", strings.Join(matched, ", "))
+}
+
+// interestScore rewards events whose category is one of the volunteer's
+// interests, and partially those that mention an interest in their text.
+func interestScore(interests []string, event *models.Event) (float64, string) {
+	category := strings.ToLower(strings.TrimSpace(event.Category))
+	text := strings.ToLower(event.Title + " " + event.Description)
+
+	best, reason := 0.0, ""
+	for _, interest := range interests {
+		interest = strings.TrimSpace(interest)
+		normalized := strings.ToLower(interest)
+		if normalized == "" {
+			continue
+		}
+		if normalized == category {
+			return 1, fmt.Sprintf("Matches your interest in %s", interest)
+		}
+		if best == 0 && strings.Contains(text, normalized) {
+			best, reason = 0.5, fmt.Sprintf("Related to your interest in %s", interest)
+		}
+	}
+
+	return best, reason
+}
+
+// locationScore compares free-text locations: the same location is a full
+// match and a shared component such as the city or state is a partial one.
+// It is not a distance; nearby places with different names do not match.
+func locationScore(volunteerLocation, eventLocation string) (float64, string) {
+	from := strings.ToLower(strings.TrimSpace(volunteerLocation))
+	to := strings.ToLower(strings.TrimSpace(eventLocation))
+	if from == "" || to == "" {
+		return 0, ""
+	}
+	if from == to {
+		return 1, fmt.Sprintf("Matches your location (%s)", eventLocation)
+	}
+
+	parts := make(map[string]bool)
+	for _, part := range strings.Split(from, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			parts[part] = true
+		}
+	}
+	for _, part := range strings.Split(eventLocation, ",") {
+		part = strings.TrimSpace(part)
+		if parts[strings.ToLower(part)] {
+			return 0.5, fmt.Sprintf("Shares part of your location (%s)", part)
+		}
+	}
+
+	return 0, ""
+}
+
+// windowScore checks the event against the volunteer's weekly windows: a
+// full match when one covers the whole event and a partial one when the
+// volunteer is free for some of that day.
+func windowScore(availability *models.VolunteerAvailability, event *models.Event) (float64, string) {
+	if availability.Covers(event.StartTime, event.EndTime) {
+		start := event.StartTime.In(availability.Zone())
+		return 1, fmt.Sprintf("Fits your availability (%s %s)", start.Weekday(), start.Format("15:04"))
+	}
+
+	weekday := event.StartTime.In(availability.Zone()).Weekday()
+	for _, window := range availability.Windows {
+		if window.Weekday == int(weekday) {
+			return 0.5, fmt.Sprintf("Falls on a day you are available (%s)", weekday)
+		}
+	}
+	return 0, ""
+}
+
+// scheduleScore checks the event's day and time of day against the keywords
+// in the volunteer's free-text availability, e.g. "weekends" or "Monday
+// evenings". It is used for volunteers who have not set weekly windows.
+func scheduleScore(availability string, event *models.Event) (float64, string) {
+	text := strings.ToLower(availability)
+	if strings.TrimSpace(text) == "" {
+		return 0, ""
+	}
+	if strings.Contains(text, "flexible") || strings.Contains(text, "anytime") || strings.Contains(text, "any time") {
+		return 1, "Fits your flexible availability"
+	}
+
+	// Compare against the event's wall clock, not UTC
+	start := event.StartTime
+	if start.IsZero() {
+		start = event.Date
+	}
+	start = start.In(event.Zone())
+
+	weekday := start.Weekday()
+	isWeekend := weekday == time.Saturday || weekday == time.Sunday
+	dayMentioned := strings.Contains(text, "weekend") || strings.Contains(text, "weekday")
+	dayFits := (isWeekend && strings.Contains(text, "weekend")) || (!isWeekend && strings.Contains(text, "weekday"))
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.Contains(text, strings.ToLower(day.String())) {
+			dayMentioned = true
+			dayFits = dayFits || day == weekday
+		}
+	}
+
+	var partOfDay string
+	switch hour := start.Hour(); {
+	case hour < 12:
+		partOfDay = "morning"
+	case hour < 17:
+		partOfDay = "afternoon"
+	default:
+		partOfDay = "evening"
+	}
+	timeMentioned := strings.Contains(text, "morning") || strings.Contains(text, "afternoon") || strings.Contains(text, "evening")
+	timeFits := strings.Contains(text, partOfDay)
+
+	reason := fmt.Sprintf("Fits your availability (%s %s)", weekday, partOfDay)
+	switch {
+	case !dayMentioned && !timeMentioned:
+		return 0, ""
+	case dayMentioned && !dayFits:
+		return 0, ""
+	case timeMentioned && !timeFits && dayMentioned:
+		return 0.5, fmt.Sprintf("Falls on a day you are available (%s)", weekday)
+	case timeMentioned && !timeFits:
+		return 0, ""
+	default:
+		return 1, reason
+	}
+}
+
+// historyScore favors organizations the volunteer has attended events with,
+// saturating after three events.
+func historyScore(attended int, organizationName string) (float64, string) {
+	if attended == 0 {
+		return 0, ""
+	}
+
+	score := float64(attended) / 3
+	if score > 1 {
+		score = 1
+	}
+
+	organization := organizationName
+	if organization == "" {
+		organization = "this organization"
+	}
+	return score, fmt.Sprintf("You have attended %d event(s) with %s", attended, organization)
+}
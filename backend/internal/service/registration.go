@@ -0,0 +1,499 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"volunteer-management/internal/config"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+	"volunteer-management/internal/websocket"
+	"volunteer-management/pkg/cache"
+)
+
+var (
+	ErrRegistrationNotFound     = errors.New("registration not found")
+	ErrAlreadyRegistered        = errors.New("volunteer is already registered for this event")
+	ErrInvalidRegistrationState = errors.New("registration cannot move to that status")
+	ErrInvalidEventHost         = errors.New("organization does not host this event")
+	ErrRegistrationNotPending   = errors.New("only a pending registration can be approved or declined")
+	ErrEventNotOpen             = errors.New("event is not open for registration")
+)
+
+type RegistrationService struct {
+	eventRegRepo        repository.EventRegistrationRepository
+	eventRepo           repository.EventRepository
+	volunteerRepo       VolunteerRepository
+	formRepo            repository.EventFormRepository
+	waiverRepo          repository.WaiverRepository
+	cohostRepo          repository.EventCohostRepository
+	organizationRepo    repository.OrganizationRepository
+	reliabilityService  *ReliabilityService
+	notificationService *NotificationService
+	cacheService        *CacheService
+	wsManager           *websocket.Manager
+	cfg                 config.SignupConfig
+	verificationService *VerificationService
+}
+
+func NewRegistrationService(eventRegRepo repository.EventRegistrationRepository, eventRepo repository.EventRepository, volunteerRepo VolunteerRepository, formRepo repository.EventFormRepository, waiverRepo repository.WaiverRepository, cohostRepo repository.EventCohostRepository, organizationRepo repository.OrganizationRepository, reliabilityService *ReliabilityService, notificationService *NotificationService, cacheService *CacheService, wsManager *websocket.Manager, cfg config.SignupConfig, verificationService *VerificationService) *RegistrationService {
+	return &RegistrationService{
+		eventRegRepo:        eventRegRepo,
+		eventRepo:           eventRepo,
+		volunteerRepo:       volunteerRepo,
+		formRepo:            formRepo,
+		waiverRepo:          waiverRepo,
+		cohostRepo:          cohostRepo,
+		organizationRepo:    organizationRepo,
+		reliabilityService:  reliabilityService,
+		notificationService: notificationService,
+		cacheService:        cacheService,
+		wsManager:           wsManager,
+		cfg:                 cfg,
+		verificationService: verificationService,
+	}
+}
+
+// Create registers a volunteer for an event, recording their signature of
+// the event's waiver. Volunteers can only register themselves; admins may
+// register any volunteer who has already signed the waiver.
+func (s *RegistrationService) Create(ctx context.Context, input *models.CreateEventRegistrationInput, userID int64, role string) (*models.EventRegistration, error) {
+	volunteer, err := s.checkVolunteer(ctx, input.VolunteerID, userID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the volunteer can sign a waiver as themselves
+	if volunteer.UserID != userID {
+		input.Waiver = nil
+	}
+
+	// Verify event exists
+	event, err := s.eventRepo.GetByID(ctx, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, ErrEventNotFound
+	}
+	if event.Status != models.EventStatusActive {
+		return nil, ErrEventNotOpen
+	}
+
+	// Registrations for co-hosted events may credit one of the hosts
+	if input.HostOrganizationID != nil {
+		isHost, err := isEventHost(ctx, s.cohostRepo, event, *input.HostOrganizationID)
+		if err != nil {
+			return nil, err
+		}
+		if !isHost {
+			return nil, ErrInvalidEventHost
+		}
+	}
+
+	// Check if already registered; a declined or cancelled registration is
+	// reopened
+	existing, err := s.eventRegRepo.GetByEventAndVolunteer(ctx, input.EventID, input.VolunteerID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && !existing.CanReopen() {
+		return nil, ErrAlreadyRegistered
+	}
+
+	// Overlapping commitments lead to no-shows
+	if err := s.checkScheduleConflicts(ctx, input.VolunteerID, event, input.AllowConflicts); err != nil {
+		return nil, err
+	}
+
+	// Events may require a minimum verification level
+	if err := s.verificationService.CheckSignupLevel(ctx, event, input.VolunteerID); err != nil {
+		return nil, err
+	}
+
+	// Organizations may require a minimum reliability score, or approval
+	// below a threshold
+	requireApproval, err := s.reliabilityService.CheckSignupPolicy(ctx, event, input.VolunteerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Registrations wait for a coordinator under manual approval
+	input.Status = models.RegistrationStatusRegistered
+	if requireApproval || event.SignupApproval == models.SignupApprovalManual {
+		input.Status = models.RegistrationStatusPending
+	}
+
+	// Validate answers against the current version of the event's form
+	form, err := s.formRepo.GetCurrent(ctx, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if form != nil {
+		if err := validateAnswers(form.Questions, input.Answers); err != nil {
+			return nil, err
+		}
+		input.FormID = &form.ID
+	} else if len(input.Answers) > 0 {
+		return nil, ErrEventFormNotFound
+	}
+
+	// Volunteers must sign the current version of the event's waiver once
+	signature, err := pendingWaiverSignature(ctx, s.waiverRepo, event, input)
+	if err != nil {
+		return nil, err
+	}
+
+	// The seat check and the signature are part of the insert's transaction
+	registration, err := s.eventRegRepo.Create(ctx, input, signature)
+	if err != nil {
+		return nil, err
+	}
+	if registration == nil {
+		return nil, ErrEventCapacityFull
+	}
+
+	// Cache the new registration
+	if err := s.cacheService.cache.Set(ctx, cache.RegistrationKey(registration.ID), registration, cache.DefaultTTL); err != nil {
+		// Log error but don't fail the operation
+		// logger.Error("Failed to cache registration", err)
+	}
+
+	// Invalidate related cache entries
+	s.invalidateRelatedCaches(ctx, registration.EventID, registration.VolunteerID)
+
+	// Notify subscribers about the new registration
+	s.publish(registration)
+
+	return registration, nil
+}
+
+func (s *RegistrationService) GetByID(ctx context.Context, id int64) (*models.EventRegistration, error) {
+	// Try to get from cache first
+	var cached models.EventRegistration
+	err := s.cacheService.cache.Get(ctx, cache.RegistrationKey(id), &cached)
+	if err != nil {
+		// Log error but continue to database
+		// logger.Error("Cache get failed", err)
+	}
+	if cached.ID != 0 {
+		return &cached, nil
+	}
+
+	// If not in cache or cache failed, get from database
+	registration, err := s.eventRegRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if registration == nil {
+		return nil, ErrRegistrationNotFound
+	}
+
+	// Cache the registration for future requests
+	if err := s.cacheService.cache.Set(ctx, cache.RegistrationKey(id), registration, cache.DefaultTTL); err != nil {
+		// Log error but don't fail the operation
+		// logger.Error("Failed to cache registration", err)
+	}
+
+	return registration, nil
+}
+
+// Update moves a registration along its lifecycle; transitions the lifecycle
+// does not allow are refused. Volunteers can only update their own
+// registrations; admins may update any.
+func (s *RegistrationService) Update(ctx context.Context, id int64, input *models.UpdateEventRegistrationInput, userID int64, role string) (*models.EventRegistration, error) {
+	current, err := s.eventRegRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, ErrRegistrationNotFound
+	}
+	if _, err := s.checkVolunteer(ctx, current.VolunteerID, userID, role); err != nil {
+		return nil, err
+	}
+	if input.Status != nil && !models.CanTransitionRegistration(current.Status, *input.Status) {
+		return nil, ErrInvalidRegistrationState
+	}
+
+	registration, err := s.eventRegRepo.Update(ctx, id, input)
+	if err != nil {
+		return nil, err
+	}
+	if registration == nil {
+		return nil, ErrRegistrationNotFound
+	}
+
+	// Update cache
+	if err := s.cacheService.cache.Set(ctx, cache.RegistrationKey(id), registration, cache.DefaultTTL); err != nil {
+		// Log error but don't fail the operation
+		// logger.Error("Failed to update registration cache", err)
+	}
+
+	// Invalidate related cache entries
+	s.invalidateRelatedCaches(ctx, registration.EventID, registration.VolunteerID)
+
+	return registration, nil
+}
+
+// Cancel withdraws a volunteer from an event. Registrations are never
+// deleted, so attendance and the hours credited for it are kept; attended
+// and no-show registrations cannot be cancelled.
+func (s *RegistrationService) Cancel(ctx context.Context, id, userID int64, role string) (*models.EventRegistration, error) {
+	status := models.RegistrationStatusCancelled
+	return s.Update(ctx, id, &models.UpdateEventRegistrationInput{Status: &status}, userID, role)
+}
+
+func (s *RegistrationService) ListByEvent(ctx context.Context, eventID int64, offset, limit int) ([]*models.EventRegistration, error) {
+	// Try to get from cache first
+	key := cache.RegistrationsByEventKey(eventID)
+	var registrations []*models.EventRegistration
+	err := s.cacheService.cache.Get(ctx, key, &registrations)
+	if err != nil {
+		// Log error but continue to database
+		// logger.Error("Cache get failed", err)
+	}
+	if registrations != nil {
+		return registrations, nil
+	}
+
+	// If not in cache or cache failed, get from database
+	registrations, err = s.eventRegRepo.ListByEvent(ctx, eventID, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cache the results
+	if err := s.cacheService.cache.Set(ctx, key, registrations, cache.ListingTTL); err != nil {
+		// Log error but don't fail the operation
+		// logger.Error("Failed to cache registration list", err)
+	}
+
+	return registrations, nil
+}
+
+func (s *RegistrationService) ListByVolunteer(ctx context.Context, volunteerID int64, offset, limit int) ([]*models.EventRegistration, error) {
+	// Try to get from cache first
+	key := cache.RegistrationsByVolunteerKey(volunteerID)
+	var registrations []*models.EventRegistration
+	err := s.cacheService.cache.Get(ctx, key, &registrations)
+	if err != nil {
+		// Log error but continue to database
+		// logger.Error("Cache get failed", err)
+	}
+	if registrations != nil {
+		return registrations, nil
+	}
+
+	// If not in cache or cache failed, get from database
+	registrations, err = s.eventRegRepo.ListByVolunteer(ctx, volunteerID, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cache the results
+	if err := s.cacheService.cache.Set(ctx, key, registrations, cache.ListingTTL); err != nil {
+		// Log error but don't fail the operation
+		// logger.Error("Failed to cache registration list", err)
+	}
+
+	return registrations, nil
+}
+
+// ListPending returns an event's registrations awaiting a decision to a
+// coordinator of one of its hosts.
+func (s *RegistrationService) ListPending(ctx context.Context, eventID, userID int64, offset, limit int) ([]*models.EventRegistration, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, ErrEventNotFound
+	}
+	if err := s.checkCoordinator(ctx, event, userID); err != nil {
+		return nil, err
+	}
+
+	return s.eventRegRepo.ListByEventAndStatus(ctx, eventID, models.RegistrationStatusPending, offset, limit)
+}
+
+// Decide lets a coordinator approve or decline a pending registration.
+// Approvals only succeed while the event has a seat left. The volunteer is notified of
+// either decision.
+func (s *RegistrationService) Decide(ctx context.Context, id int64, input *models.RegistrationDecisionInput, userID int64) (*models.EventRegistration, error) {
+	registration, err := s.eventRegRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if registration == nil {
+		return nil, ErrRegistrationNotFound
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, registration.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, ErrEventNotFound
+	}
+	if err := s.checkCoordinator(ctx, event, userID); err != nil {
+		return nil, err
+	}
+
+	if registration.Status != models.RegistrationStatusPending {
+		return nil, ErrRegistrationNotPending
+	}
+
+	now := time.Now()
+	registration.Status = models.RegistrationStatusDeclined
+	if input.Action == "approve" {
+		registration.Status = models.RegistrationStatusRegistered
+	}
+	registration.DecisionMessage = strings.TrimSpace(input.Message)
+	registration.DecidedBy = &userID
+	registration.DecidedAt = &now
+	registration.UpdatedAt = now
+
+	decided, err := s.eventRegRepo.Decide(ctx, registration)
+	if err != nil {
+		return nil, err
+	}
+	if !decided {
+		current, err := s.eventRegRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if current == nil {
+			return nil, ErrRegistrationNotFound
+		}
+		if current.Status != models.RegistrationStatusPending {
+			return nil, ErrRegistrationNotPending
+		}
+		return nil, ErrEventCapacityFull
+	}
+
+	// Update cache
+	if err := s.cacheService.cache.Set(ctx, cache.RegistrationKey(id), registration, cache.DefaultTTL); err != nil {
+		// Log error but don't fail the operation
+		// logger.Error("Failed to update registration cache", err)
+	}
+
+	// Invalidate related cache entries
+	s.invalidateRelatedCaches(ctx, registration.EventID, registration.VolunteerID)
+
+	s.publish(registration)
+
+	notification := &models.CreateNotificationInput{
+		Type:    models.NotificationSignupApproved,
+		Title:   fmt.Sprintf("Your registration for %s was approved", event.Title),
+		Message: registration.DecisionMessage,
+		EventID: &event.ID,
+	}
+	if registration.Status == models.RegistrationStatusDeclined {
+		notification.Type = models.NotificationSignupDeclined
+		notification.Title = fmt.Sprintf("Your registration for %s was declined", event.Title)
+	}
+	if _, err := s.notificationService.NotifyVolunteer(ctx, registration.VolunteerID, notification); err != nil {
+		// Log error but don't fail the operation
+		// logger.Error("Failed to notify volunteer of registration decision", err)
+	}
+
+	return registration, nil
+}
+
+// checkVolunteer returns the volunteer, who unless the user is an admin must
+// belong to the user.
+func (s *RegistrationService) checkVolunteer(ctx context.Context, volunteerID, userID int64, role string) (*models.Volunteer, error) {
+	if models.Role(role) == models.RoleAdmin {
+		volunteer, err := s.volunteerRepo.GetByID(ctx, volunteerID)
+		if err != nil {
+			return nil, err
+		}
+		if volunteer == nil {
+			return nil, ErrVolunteerNotFound
+		}
+		return volunteer, nil
+	}
+
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+	if volunteer.ID != volunteerID {
+		return nil, ErrUnauthorized
+	}
+	return volunteer, nil
+}
+
+// checkCoordinator requires the user to belong to an organization hosting the
+// event.
+func (s *RegistrationService) checkCoordinator(ctx context.Context, event *models.Event, userID int64) error {
+	org, err := s.organizationRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if org == nil {
+		return ErrUnauthorized
+	}
+
+	isHost, err := isEventHost(ctx, s.cohostRepo, event, org.ID)
+	if err != nil {
+		return err
+	}
+	if !isHost {
+		return ErrUnauthorized
+	}
+	return nil
+}
+
+// invalidateCancelled purges the cache entries of registrations cancelled
+// along with their event.
+func (s *RegistrationService) invalidateCancelled(ctx context.Context, eventID int64, registrations []*models.EventRegistration) {
+	for _, registration := range registrations {
+		if err := s.cacheService.cache.Delete(ctx, cache.RegistrationKey(registration.ID)); err != nil {
+			// Log error but don't fail the operation
+			// logger.Error("Failed to delete registration from cache", err)
+		}
+		s.invalidateRelatedCaches(ctx, registration.EventID, registration.VolunteerID)
+	}
+
+	// The event's registration list is stale even when no registration was open
+	if err := s.cacheService.cache.Delete(ctx, cache.RegistrationsByEventKey(eventID)); err != nil {
+		// logger.Error("Failed to invalidate event registrations cache", err)
+	}
+}
+
+// HasRegistrations reports whether any registration, in any status,
+// references the event.
+func (s *RegistrationService) HasRegistrations(ctx context.Context, eventID int64) (bool, error) {
+	registrations, err := s.eventRegRepo.ListByEvent(ctx, eventID, 0, 1)
+	if err != nil {
+		return false, err
+	}
+	return len(registrations) > 0, nil
+}
+
+// publish broadcasts the registration to its event's and volunteer's topics.
+func (s *RegistrationService) publish(registration *models.EventRegistration) {
+	update := models.NewRegistrationUpdate(registration)
+	s.wsManager.PublishToTopic(fmt.Sprintf("event:%d", registration.EventID), update)
+	s.wsManager.PublishToTopic(fmt.Sprintf("volunteer:%d", registration.VolunteerID), update)
+}
+
+// Helper method to invalidate related cache entries when a registration is modified
+func (s *RegistrationService) invalidateRelatedCaches(ctx context.Context, eventID, volunteerID int64) {
+	// Delete event registrations list cache
+	if err := s.cacheService.cache.Delete(ctx, cache.RegistrationsByEventKey(eventID)); err != nil {
+		// logger.Error("Failed to invalidate event registrations cache", err)
+	}
+
+	// Delete volunteer registrations list cache
+	if err := s.cacheService.cache.Delete(ctx, cache.RegistrationsByVolunteerKey(volunteerID)); err != nil {
+		// logger.Error("Failed to invalidate volunteer registrations cache", err)
+	}
+}
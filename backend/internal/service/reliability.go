@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"volunteer-management/internal/config"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+)
+
+var (
+	ErrReliabilityAppealNotFound = errors.New("reliability appeal not found")
+	ErrReliabilityAppealExists   = errors.New("this event has already been appealed")
+	ErrNothingToAppeal           = errors.New("only a no-show or late cancellation counted in the score can be appealed")
+)
+
+// lateCancellationWeight is how much a late cancellation counts against the
+// score relative to a no-show.
+const lateCancellationWeight = 0.5
+
+// ReliabilityTooLowError refuses a signup to an organization that requires a
+// higher reliability score.
+type ReliabilityTooLowError struct {
+	Score    int
+	Required int
+}
+
+func (e *ReliabilityTooLowError) Error() string {
+	return fmt.Sprintf("a reliability score of %d is required to sign up; the volunteer's score is %d", e.Required, e.Score)
+}
+
+type ReliabilityService struct {
+	reliabilityRepo     repository.ReliabilityRepository
+	organizationRepo    repository.OrganizationRepository
+	volunteerRepo       VolunteerRepository
+	notificationService *NotificationService
+	cfg                 config.ReliabilityConfig
+	eventRegRepo        repository.EventRegistrationRepository
+}
+
+func NewReliabilityService(
+	reliabilityRepo repository.ReliabilityRepository,
+	organizationRepo repository.OrganizationRepository,
+	volunteerRepo VolunteerRepository,
+	notificationService *NotificationService,
+	cfg config.ReliabilityConfig,
+	eventRegRepo repository.EventRegistrationRepository) *ReliabilityService {
+	return &ReliabilityService{
+		reliabilityRepo:     reliabilityRepo,
+		organizationRepo:    organizationRepo,
+		volunteerRepo:       volunteerRepo,
+		notificationService: notificationService,
+		cfg:                 cfg,
+		eventRegRepo:        eventRegRepo,
+	}
+}
+
+// GetScore computes the volunteer's score over the rolling window, starting
+// no earlier than the last reset, with the events it is based on.
+func (s *ReliabilityService) GetScore(ctx context.Context, volunteerID int64) (*models.ReliabilityScore, error) {
+	now := time.Now()
+	score := &models.ReliabilityScore{
+		VolunteerID: volunteerID,
+		Since:       now.Add(-s.cfg.Window),
+	}
+
+	reset, err := s.reliabilityRepo.GetLatestReset(ctx, volunteerID)
+	if err != nil {
+		return nil, err
+	}
+	if reset != nil {
+		score.ResetAt = &reset.CreatedAt
+		if reset.CreatedAt.After(score.Since) {
+			score.Since = reset.CreatedAt
+		}
+	}
+
+	records, err := s.reliabilityRepo.ListRecords(ctx, volunteerID, score.Since, now, s.cfg.LateCancelWindow)
+	if err != nil {
+		return nil, err
+	}
+	excused, err := s.reliabilityRepo.ListExcusedEventIDs(ctx, volunteerID)
+	if err != nil {
+		return nil, err
+	}
+	isExcused := make(map[int64]bool, len(excused))
+	for _, id := range excused {
+		isExcused[id] = true
+	}
+
+	for _, record := range records {
+		if isExcused[record.EventID] {
+			record.Excused = true
+			score.Excused++
+			continue
+		}
+		switch record.Outcome {
+		case models.ReliabilityOutcomeAttended:
+			score.Attended++
+		case models.ReliabilityOutcomeNoShow:
+			score.NoShows++
+		case models.ReliabilityOutcomeLateCancellation:
+			score.LateCancellations++
+		}
+	}
+	score.Records = records
+
+	counted := score.Attended + score.NoShows + score.LateCancellations
+	if counted > 0 && counted >= s.cfg.MinEvents {
+		weighted := float64(score.Attended+score.NoShows) + lateCancellationWeight*float64(score.LateCancellations)
+		value := int(math.Round(100 * float64(score.Attended) / weighted))
+		score.Score = &value
+	}
+	score.Explanation = s.explain(score, counted)
+
+	return score, nil
+}
+
+func (s *ReliabilityService) explain(score *models.ReliabilityScore, counted int) []string {
+	since := fmt.Sprintf("Counts events since %s.", score.Since.Format("Jan 2, 2006"))
+	if score.ResetAt != nil && !score.ResetAt.Before(score.Since) {
+		since = fmt.Sprintf("Counts events since an admin reset the score on %s.", score.ResetAt.Format("Jan 2, 2006"))
+	}
+
+	explanation := []string{
+		since,
+		fmt.Sprintf("Attended %d, missed %d without cancelling and cancelled %d less than %g hours before the start.",
+			score.Attended, score.NoShows, score.LateCancellations, s.cfg.LateCancelWindow.Hours()),
+		"No-shows count fully against the score and late cancellations count half.",
+	}
+	if score.Excused > 0 {
+		explanation = append(explanation, fmt.Sprintf("%d excused on appeal and not counted.", score.Excused))
+	}
+	if score.Score == nil {
+		explanation = append(explanation, fmt.Sprintf("A score is given once %d events count; %d do so far.", s.cfg.MinEvents, counted))
+	} else {
+		explanation = append(explanation, fmt.Sprintf("The score is %d out of 100.", *score.Score))
+	}
+	return explanation
+}
+
+func (s *ReliabilityService) GetMine(ctx context.Context, userID int64) (*models.ReliabilityScore, error) {
+	volunteer, err := s.currentVolunteer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetScore(ctx, volunteer.ID)
+}
+
+// GetForVolunteer returns a volunteer's score to an admin or to an
+// organization the volunteer has registered with. Organizations get the
+// totals but not the events they were counted from, which other
+// organizations may have hosted.
+func (s *ReliabilityService) GetForVolunteer(ctx context.Context, volunteerID int64, userID int64, role string) (*models.ReliabilityScore, error) {
+	volunteer, err := s.volunteerRepo.GetByID(ctx, volunteerID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+
+	if models.Role(role) == models.RoleAdmin {
+		return s.GetScore(ctx, volunteerID)
+	}
+
+	org, err := s.organizationRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, ErrUnauthorized
+	}
+	registered, err := s.eventRegRepo.HasRegisteredWith(ctx, volunteerID, org.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !registered {
+		return nil, ErrUnauthorized
+	}
+
+	score, err := s.GetScore(ctx, volunteerID)
+	if err != nil {
+		return nil, err
+	}
+	score.Records = nil
+	return score, nil
+}
+
+// CheckSignupPolicy applies the signup policy of the event's organization to
+// the volunteer. It fails when the volunteer's score is below the required
+// minimum and reports whether the signup must wait for approval. Volunteers
+// without a score yet are not held back.
+func (s *ReliabilityService) CheckSignupPolicy(ctx context.Context, event *models.Event, volunteerID int64) (bool, error) {
+	org, err := s.organizationRepo.GetByID(ctx, event.OrganizationID)
+	if err != nil {
+		return false, err
+	}
+	if org == nil || (org.MinReliabilityScore == nil && org.ApprovalBelowScore == nil) {
+		return false, nil
+	}
+
+	score, err := s.GetScore(ctx, volunteerID)
+	if err != nil {
+		return false, err
+	}
+	if score.Score == nil {
+		return false, nil
+	}
+
+	if org.MinReliabilityScore != nil && *score.Score < *org.MinReliabilityScore {
+		return false, &ReliabilityTooLowError{Score: *score.Score, Required: *org.MinReliabilityScore}
+	}
+	return org.ApprovalBelowScore != nil && *score.Score < *org.ApprovalBelowScore, nil
+}
+
+// Reset lets an admin clear a volunteer's history so that only later events
+// count.
+func (s *ReliabilityService) Reset(ctx context.Context, volunteerID int64, input *models.ResetReliabilityInput, adminID int64) (*models.ReliabilityScore, error) {
+	volunteer, err := s.volunteerRepo.GetByID(ctx, volunteerID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+
+	if err := s.reliabilityRepo.CreateReset(ctx, &models.ReliabilityReset{
+		VolunteerID: volunteerID,
+		Reason:      strings.TrimSpace(input.Reason),
+		ResetBy:     adminID,
+	}); err != nil {
+		return nil, err
+	}
+
+	notification := &models.CreateNotificationInput{
+		Type:    models.NotificationReliabilityReset,
+		Title:   "Your reliability score was reset",
+		Message: strings.TrimSpace(input.Reason),
+	}
+	if _, err := s.notificationService.NotifyVolunteer(ctx, volunteerID, notification); err != nil {
+		// Log error but don't fail the operation
+		// logger.Error("Failed to notify volunteer of reliability reset", err)
+	}
+
+	return s.GetScore(ctx, volunteerID)
+}
+
+// Appeal asks an admin to excuse a no-show or late cancellation that counts
+// in the volunteer's current score. Each event can be appealed once.
+func (s *ReliabilityService) Appeal(ctx context.Context, input *models.CreateReliabilityAppealInput, userID int64) (*models.ReliabilityAppeal, error) {
+	volunteer, err := s.currentVolunteer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	score, err := s.GetScore(ctx, volunteer.ID)
+	if err != nil {
+		return nil, err
+	}
+	appealable := false
+	for _, record := range score.Records {
+		if record.EventID == input.EventID && record.Outcome != models.ReliabilityOutcomeAttended {
+			appealable = true
+			break
+		}
+	}
+	if !appealable {
+		return nil, ErrNothingToAppeal
+	}
+
+	existing, err := s.reliabilityRepo.GetAppealByEventAndVolunteer(ctx, input.EventID, volunteer.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrReliabilityAppealExists
+	}
+
+	appeal := &models.ReliabilityAppeal{
+		VolunteerID: volunteer.ID,
+		EventID:     input.EventID,
+		Reason:      strings.TrimSpace(input.Reason),
+		Status:      models.ReliabilityAppealPending,
+	}
+	if err := s.reliabilityRepo.CreateAppeal(ctx, appeal); err != nil {
+		return nil, err
+	}
+	return s.reliabilityRepo.GetAppeal(ctx, appeal.ID)
+}
+
+func (s *ReliabilityService) ListMyAppeals(ctx context.Context, userID int64) ([]*models.ReliabilityAppeal, error) {
+	volunteer, err := s.currentVolunteer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.reliabilityRepo.ListAppealsByVolunteer(ctx, volunteer.ID)
+}
+
+func (s *ReliabilityService) ListPendingAppeals(ctx context.Context, offset, limit int) ([]*models.ReliabilityAppeal, error) {
+	return s.reliabilityRepo.ListAppealsByStatus(ctx, models.ReliabilityAppealPending, offset, limit)
+}
+
+// ResolveAppeal accepts an appeal, excusing the event from the score, or
+// rejects it, and tells the volunteer.
+func (s *ReliabilityService) ResolveAppeal(ctx context.Context, id int64, input *models.ResolveReliabilityAppealInput, adminID int64) (*models.ReliabilityAppeal, error) {
+	status := models.ReliabilityAppealRejected
+	if input.Action == "accept" {
+		status = models.ReliabilityAppealAccepted
+	}
+
+	appeal, err := s.reliabilityRepo.ResolveAppeal(ctx, id, status, strings.TrimSpace(input.Note), adminID)
+	if err != nil {
+		return nil, err
+	}
+	if appeal == nil {
+		return nil, ErrReliabilityAppealNotFound
+	}
+
+	notification := &models.CreateNotificationInput{
+		Type:    models.NotificationReliabilityAppealResolved,
+		Title:   fmt.Sprintf("Your reliability appeal for %s was %s", appeal.EventTitle, appeal.Status),
+		Message: appeal.ResolutionNote,
+		EventID: &appeal.EventID,
+	}
+	if _, err := s.notificationService.NotifyVolunteer(ctx, appeal.VolunteerID, notification); err != nil {
+		// Log error but don't fail the operation
+		// logger.Error("Failed to notify volunteer of appeal decision", err)
+	}
+
+	return appeal, nil
+}
+
+func (s *ReliabilityService) currentVolunteer(ctx context.Context, userID int64) (*models.Volunteer, error) {
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+	return volunteer, nil
+}
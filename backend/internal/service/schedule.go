@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"time"
+	"volunteer-management/internal/models"
+)
+
+// ConflictPolicyReject refuses registrations that overlap the volunteer's schedule;
+// any other policy warns and accepts an explicit override.
+const ConflictPolicyReject = "reject"
+
+// defaultScheduleWindow is how far ahead a schedule looks when no end is given.
+const defaultScheduleWindow = 90 * 24 * time.Hour
+
+// ScheduleConflictError lists the commitments a new registration overlaps.
+// Overridable is set when resubmitting with allow_conflicts will succeed.
+type ScheduleConflictError struct {
+	Conflicts   []*models.ScheduleEntry
+	Overridable bool
+}
+
+func (e *ScheduleConflictError) Error() string {
+	return "event overlaps the volunteer's other commitments"
+}
+
+// GetSchedule returns the commitments of the user's volunteer profile in
+// [from, to), marking the entries that overlap each other.
+func (s *RegistrationService) GetSchedule(ctx context.Context, userID int64, from, to time.Time) (*models.Schedule, error) {
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+
+	if from.IsZero() {
+		from = time.Now()
+	}
+	if to.IsZero() {
+		to = from.Add(defaultScheduleWindow)
+	}
+
+	entries, err := s.eventRepo.ListCommitments(ctx, volunteer.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := &models.Schedule{
+		From:         from,
+		To:           to,
+		TravelBuffer: s.cfg.TravelBuffer.String(),
+		Entries:      entries,
+	}
+	for i, a := range entries {
+		for j, b := range entries {
+			if i != j && overlaps(a.StartTime, a.EndTime, b.StartTime, b.EndTime, s.cfg.TravelBuffer) {
+				a.ConflictsWith = append(a.ConflictsWith, b.EventID)
+			}
+		}
+		if len(a.ConflictsWith) > 0 {
+			schedule.Conflicts++
+		}
+	}
+
+	return schedule, nil
+}
+
+// checkScheduleConflicts returns a ScheduleConflictError when the event
+// overlaps the volunteer's commitments, unless the policy allows the override.
+func (s *RegistrationService) checkScheduleConflicts(ctx context.Context, volunteerID int64, event *models.Event, allowConflicts bool) error {
+	buffer := s.cfg.TravelBuffer
+	start, end := event.StartTime, event.EndTime
+	commitments, err := s.eventRepo.ListCommitments(ctx, volunteerID, start.Add(-buffer), end.Add(buffer))
+	if err != nil {
+		return err
+	}
+
+	var conflicts []*models.ScheduleEntry
+	for _, commitment := range commitments {
+		if commitment.EventID != event.ID && overlaps(start, end, commitment.StartTime, commitment.EndTime, buffer) {
+			conflicts = append(conflicts, commitment)
+		}
+	}
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	overridable := s.cfg.ConflictPolicy != ConflictPolicyReject
+	if overridable && allowConflicts {
+		return nil
+	}
+	return &ScheduleConflictError{Conflicts: conflicts, Overridable: overridable}
+}
+
+// overlaps reports whether two time ranges come closer than buffer. Ranges
+// that merely touch overlap only when a buffer is required.
+func overlaps(aStart, aEnd, bStart, bEnd time.Time, buffer time.Duration) bool {
+	if aEnd.Before(aStart) {
+		aEnd = aStart
+	}
+	if bEnd.Before(bStart) {
+		bEnd = bStart
+	}
+	return aStart.Before(bEnd.Add(buffer)) && bStart.Before(aEnd.Add(buffer))
+}
@@ -0,0 +1,379 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+)
+
+var (
+	ErrSchoolNotFound        = errors.New("school not found")
+	ErrSchoolTermNotFound    = errors.New("term not found")
+	ErrSchoolStudentNotFound = errors.New("student link not found")
+	ErrNotSchoolAdmin        = errors.New("the school's admin must be a user with the school_admin role")
+	ErrSchoolAdminTaken      = errors.New("this user already administers a school")
+	ErrInvalidSchoolTerm     = errors.New("term dates must be YYYY-MM-DD, with the start on or before the end")
+	ErrAlreadyLinkedToSchool = errors.New("volunteer has already asked to link to this school")
+)
+
+type SchoolService struct {
+	schoolRepo          repository.SchoolRepository
+	volunteerRepo       VolunteerRepository
+	userRepo            repository.UserRepository
+	notificationService *NotificationService
+}
+
+func NewSchoolService(
+	schoolRepo repository.SchoolRepository,
+	volunteerRepo VolunteerRepository,
+	userRepo repository.UserRepository,
+	notificationService *NotificationService) *SchoolService {
+	return &SchoolService{
+		schoolRepo:          schoolRepo,
+		volunteerRepo:       volunteerRepo,
+		userRepo:            userRepo,
+		notificationService: notificationService,
+	}
+}
+
+// Create adds a school run by the given school admin. Only admins create
+// schools.
+func (s *SchoolService) Create(ctx context.Context, input *models.SchoolInput) (*models.School, error) {
+	if input.UserID == nil {
+		return nil, ErrNotSchoolAdmin
+	}
+	if err := s.checkSchoolAdmin(ctx, *input.UserID, 0); err != nil {
+		return nil, err
+	}
+
+	school := &models.School{UserID: *input.UserID}
+	applySchool(school, input)
+	if err := s.schoolRepo.Create(ctx, school); err != nil {
+		return nil, err
+	}
+	return school, nil
+}
+
+func (s *SchoolService) GetByID(ctx context.Context, id int64) (*models.School, error) {
+	school, err := s.schoolRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if school == nil {
+		return nil, ErrSchoolNotFound
+	}
+	return school, nil
+}
+
+// GetMine returns the school the user administers.
+func (s *SchoolService) GetMine(ctx context.Context, userID int64) (*models.School, error) {
+	school, err := s.schoolRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if school == nil {
+		return nil, ErrSchoolNotFound
+	}
+	return school, nil
+}
+
+func (s *SchoolService) List(ctx context.Context, offset, limit int) ([]*models.School, error) {
+	return s.schoolRepo.List(ctx, offset, limit)
+}
+
+// Update changes a school's details. Only admins may hand the school to a
+// different school admin.
+func (s *SchoolService) Update(ctx context.Context, id int64, input *models.SchoolInput, userID int64, role string) (*models.School, error) {
+	school, err := s.manage(ctx, id, userID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.UserID != nil && *input.UserID != school.UserID {
+		if models.Role(role) != models.RoleAdmin {
+			return nil, ErrUnauthorized
+		}
+		if err := s.checkSchoolAdmin(ctx, *input.UserID, school.ID); err != nil {
+			return nil, err
+		}
+		school.UserID = *input.UserID
+	}
+
+	applySchool(school, input)
+	if err := s.schoolRepo.Update(ctx, school); err != nil {
+		return nil, err
+	}
+	return school, nil
+}
+
+func (s *SchoolService) ListTerms(ctx context.Context, schoolID int64) ([]*models.SchoolTerm, error) {
+	if _, err := s.GetByID(ctx, schoolID); err != nil {
+		return nil, err
+	}
+	return s.schoolRepo.ListTerms(ctx, schoolID)
+}
+
+func (s *SchoolService) CreateTerm(ctx context.Context, schoolID int64, input *models.SchoolTermInput, userID int64, role string) (*models.SchoolTerm, error) {
+	if _, err := s.manage(ctx, schoolID, userID, role); err != nil {
+		return nil, err
+	}
+
+	term := &models.SchoolTerm{SchoolID: schoolID}
+	if err := applySchoolTerm(term, input); err != nil {
+		return nil, err
+	}
+	if err := s.schoolRepo.CreateTerm(ctx, term); err != nil {
+		return nil, err
+	}
+	return term, nil
+}
+
+func (s *SchoolService) UpdateTerm(ctx context.Context, schoolID, termID int64, input *models.SchoolTermInput, userID int64, role string) (*models.SchoolTerm, error) {
+	term, err := s.manageTerm(ctx, schoolID, termID, userID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applySchoolTerm(term, input); err != nil {
+		return nil, err
+	}
+	if err := s.schoolRepo.UpdateTerm(ctx, term); err != nil {
+		return nil, err
+	}
+	return term, nil
+}
+
+func (s *SchoolService) DeleteTerm(ctx context.Context, schoolID, termID int64, userID int64, role string) error {
+	if _, err := s.manageTerm(ctx, schoolID, termID, userID, role); err != nil {
+		return err
+	}
+	return s.schoolRepo.DeleteTerm(ctx, termID)
+}
+
+// RequestLink asks the school to accept the volunteer as one of its
+// students. A volunteer who was turned down may ask again.
+func (s *SchoolService) RequestLink(ctx context.Context, schoolID int64, input *models.LinkSchoolInput, userID int64) (*models.SchoolStudent, error) {
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+	if _, err := s.GetByID(ctx, schoolID); err != nil {
+		return nil, err
+	}
+
+	student, err := s.schoolRepo.GetStudentByVolunteer(ctx, schoolID, volunteer.ID)
+	if err != nil {
+		return nil, err
+	}
+	if student == nil {
+		student = &models.SchoolStudent{SchoolID: schoolID, VolunteerID: volunteer.ID}
+	} else if student.Status != models.SchoolStudentRejected {
+		return nil, ErrAlreadyLinkedToSchool
+	}
+
+	student.StudentNumber = strings.TrimSpace(input.StudentNumber)
+	student.Status = models.SchoolStudentPending
+	student.DecisionNote = ""
+	student.DecidedBy = nil
+	student.DecidedAt = nil
+	if err := s.schoolRepo.SaveStudent(ctx, student); err != nil {
+		return nil, err
+	}
+	return student, nil
+}
+
+// ListMine returns the schools the volunteer has linked or asked to link to.
+func (s *SchoolService) ListMine(ctx context.Context, userID int64) ([]*models.SchoolStudent, error) {
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+	return s.schoolRepo.ListVolunteerSchools(ctx, volunteer.ID)
+}
+
+// Unlink removes the volunteer's link to a school, whatever its status. The
+// school stops seeing their hours.
+func (s *SchoolService) Unlink(ctx context.Context, linkID, userID int64) error {
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if volunteer == nil {
+		return ErrVolunteerNotFound
+	}
+
+	student, err := s.schoolRepo.GetStudent(ctx, linkID)
+	if err != nil {
+		return err
+	}
+	if student == nil || student.VolunteerID != volunteer.ID {
+		return ErrSchoolStudentNotFound
+	}
+	return s.schoolRepo.DeleteStudent(ctx, linkID)
+}
+
+func (s *SchoolService) ListStudents(ctx context.Context, schoolID int64, status models.SchoolStudentStatus, userID int64, role string, offset, limit int) ([]*models.SchoolStudent, error) {
+	if _, err := s.manage(ctx, schoolID, userID, role); err != nil {
+		return nil, err
+	}
+	return s.schoolRepo.ListStudents(ctx, schoolID, status, offset, limit)
+}
+
+// DecideStudent approves or rejects a volunteer's request to link to the
+// school and lets them know.
+func (s *SchoolService) DecideStudent(ctx context.Context, schoolID, linkID int64, input *models.DecideSchoolStudentInput, userID int64, role string) (*models.SchoolStudent, error) {
+	school, err := s.manage(ctx, schoolID, userID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	student, err := s.schoolRepo.GetStudent(ctx, linkID)
+	if err != nil {
+		return nil, err
+	}
+	if student == nil || student.SchoolID != schoolID {
+		return nil, ErrSchoolStudentNotFound
+	}
+
+	now := time.Now()
+	student.Status = models.SchoolStudentRejected
+	if input.Decision == "approve" {
+		student.Status = models.SchoolStudentApproved
+	}
+	student.DecisionNote = strings.TrimSpace(input.Note)
+	student.DecidedBy = &userID
+	student.DecidedAt = &now
+	if err := s.schoolRepo.SaveStudent(ctx, student); err != nil {
+		return nil, err
+	}
+
+	notification := &models.CreateNotificationInput{
+		Type:    models.NotificationSchoolLinkDecided,
+		Title:   fmt.Sprintf("%s %s your student link", school.Name, student.Status),
+		Message: student.DecisionNote,
+	}
+	if _, err := s.notificationService.NotifyVolunteer(ctx, student.VolunteerID, notification); err != nil {
+		// Log error but don't fail the operation
+		// logger.Error("Failed to notify volunteer of school link decision", err)
+	}
+
+	return student, nil
+}
+
+// TermReport measures each approved student's approved hours in the term
+// against the term's requirement.
+func (s *SchoolService) TermReport(ctx context.Context, schoolID, termID int64, userID int64, role string) (*models.SchoolTermReport, error) {
+	school, err := s.manage(ctx, schoolID, userID, role)
+	if err != nil {
+		return nil, err
+	}
+	term, err := s.schoolRepo.GetTerm(ctx, termID)
+	if err != nil {
+		return nil, err
+	}
+	if term == nil || term.SchoolID != schoolID {
+		return nil, ErrSchoolTermNotFound
+	}
+
+	students, err := s.schoolRepo.ListTermProgress(ctx, schoolID, term)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.SchoolTermReport{School: school, Term: term, Students: students}
+	for _, student := range students {
+		student.RequiredHours = term.RequiredHours
+		student.RemainingHours = math.Max(term.RequiredHours-student.ApprovedHours, 0)
+		student.Complete = student.RemainingHours == 0
+		if student.Complete {
+			report.Completed++
+		}
+	}
+	return report, nil
+}
+
+// manage loads a school the user may manage: its own school admin, or any
+// admin.
+func (s *SchoolService) manage(ctx context.Context, schoolID, userID int64, role string) (*models.School, error) {
+	school, err := s.GetByID(ctx, schoolID)
+	if err != nil {
+		return nil, err
+	}
+	if school.UserID != userID && models.Role(role) != models.RoleAdmin {
+		return nil, ErrUnauthorized
+	}
+	return school, nil
+}
+
+func (s *SchoolService) manageTerm(ctx context.Context, schoolID, termID, userID int64, role string) (*models.SchoolTerm, error) {
+	if _, err := s.manage(ctx, schoolID, userID, role); err != nil {
+		return nil, err
+	}
+	term, err := s.schoolRepo.GetTerm(ctx, termID)
+	if err != nil {
+		return nil, err
+	}
+	if term == nil || term.SchoolID != schoolID {
+		return nil, ErrSchoolTermNotFound
+	}
+	return term, nil
+}
+
+// checkSchoolAdmin makes sure the user can run the school: they have the
+// school_admin role and do not already run a different school.
+func (s *SchoolService) checkSchoolAdmin(ctx context.Context, userID, schoolID int64) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return ErrUserNotFound
+	}
+	if user.Role != models.RoleSchoolAdmin {
+		return ErrNotSchoolAdmin
+	}
+
+	existing, err := s.schoolRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != schoolID {
+		return ErrSchoolAdminTaken
+	}
+	return nil
+}
+
+func applySchool(school *models.School, input *models.SchoolInput) {
+	school.Name = strings.TrimSpace(input.Name)
+	school.Description = strings.TrimSpace(input.Description)
+	school.Location = strings.TrimSpace(input.Location)
+	school.Website = strings.TrimSpace(input.Website)
+}
+
+func applySchoolTerm(term *models.SchoolTerm, input *models.SchoolTermInput) error {
+	start, err := time.Parse("2006-01-02", input.StartDate)
+	if err != nil {
+		return ErrInvalidSchoolTerm
+	}
+	end, err := time.Parse("2006-01-02", input.EndDate)
+	if err != nil || end.Before(start) {
+		return ErrInvalidSchoolTerm
+	}
+
+	term.Name = strings.TrimSpace(input.Name)
+	term.StartDate = start
+	term.EndDate = end
+	term.RequiredHours = input.RequiredHours
+	return nil
+}
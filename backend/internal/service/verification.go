@@ -0,0 +1,433 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+	"volunteer-management/internal/config"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+	"volunteer-management/pkg/storage"
+)
+
+var (
+	ErrVerificationDocumentNotFound = errors.New("verification document not found")
+	ErrDocumentTooLarge             = errors.New("document exceeds the maximum upload size")
+	ErrUnsupportedDocumentType      = errors.New("only PDF, JPEG and PNG documents are supported")
+	ErrDocumentAlreadyReviewed      = errors.New("document has already been reviewed")
+)
+
+// documentExtensions maps the accepted document types to the extension they
+// are stored under.
+var documentExtensions = map[string]string{
+	"application/pdf": ".pdf",
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+}
+
+// VerificationLevelTooLowError refuses a signup to an event that requires a
+// higher verification level.
+type VerificationLevelTooLowError struct {
+	Level    models.VerificationLevel
+	Required models.VerificationLevel
+}
+
+func (e *VerificationLevelTooLowError) Error() string {
+	return fmt.Sprintf("the event requires %s verification; the volunteer is verified as %s", e.Required, e.Level)
+}
+
+type VerificationService struct {
+	verificationRepo    repository.VerificationRepository
+	volunteerRepo       VolunteerRepository
+	organizationRepo    repository.OrganizationRepository
+	storage             storage.Storage
+	notificationService *NotificationService
+	cacheService        *CacheService
+	cfg                 config.StorageConfig
+}
+
+func NewVerificationService(
+	verificationRepo repository.VerificationRepository,
+	volunteerRepo VolunteerRepository,
+	organizationRepo repository.OrganizationRepository,
+	store storage.Storage,
+	notificationService *NotificationService,
+	cacheService *CacheService,
+	cfg config.StorageConfig) *VerificationService {
+	return &VerificationService{
+		verificationRepo:    verificationRepo,
+		volunteerRepo:       volunteerRepo,
+		organizationRepo:    organizationRepo,
+		storage:             store,
+		notificationService: notificationService,
+		cacheService:        cacheService,
+		cfg:                 cfg,
+	}
+}
+
+// Upload stores a volunteer's document in private storage and queues it for
+// review. Documents are never given public or signed URLs.
+func (s *VerificationService) Upload(ctx context.Context, body io.Reader, fileName string, input *models.UploadVerificationDocumentInput, userID int64) (*models.VerificationDocument, error) {
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+
+	data, err := io.ReadAll(io.LimitReader(body, s.cfg.MaxUploadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > s.cfg.MaxUploadSize {
+		return nil, ErrDocumentTooLarge
+	}
+
+	// Trust the file contents rather than the client's declared content type
+	contentType := http.DetectContentType(data)
+	ext, ok := documentExtensions[contentType]
+	if !ok {
+		return nil, ErrUnsupportedDocumentType
+	}
+
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("verification/%d/%s%s", volunteer.ID, hex.EncodeToString(random), ext)
+	if err := s.storage.Put(ctx, key, bytes.NewReader(data), int64(len(data)), contentType); err != nil {
+		return nil, err
+	}
+
+	document := &models.VerificationDocument{
+		VolunteerID: volunteer.ID,
+		Type:        input.Type,
+		FileName:    filepath.Base(strings.TrimSpace(fileName)),
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		StorageKey:  key,
+		Status:      models.VerificationDocumentPending,
+		ExpiresAt:   input.ExpiresAt,
+	}
+	if err := s.verificationRepo.CreateDocument(ctx, document); err != nil {
+		if delErr := s.storage.Delete(ctx, key); delErr != nil {
+			// logger.Error("Failed to remove orphaned document", delErr)
+		}
+		return nil, err
+	}
+	return document, nil
+}
+
+func (s *VerificationService) GetMine(ctx context.Context, userID int64) (*models.VerificationStatus, error) {
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+	return s.status(ctx, volunteer)
+}
+
+// GetForVolunteer shows a volunteer's verification to an admin, or to a
+// trusted organization the volunteer signed up with.
+func (s *VerificationService) GetForVolunteer(ctx context.Context, volunteerID, userID int64, role string) (*models.VerificationStatus, error) {
+	volunteer, err := s.volunteerRepo.GetByID(ctx, volunteerID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, ErrVolunteerNotFound
+	}
+	if _, err := s.checkReviewer(ctx, volunteerID, userID, role); err != nil {
+		return nil, err
+	}
+	return s.status(ctx, volunteer)
+}
+
+// ListPending returns the documents awaiting review that the reviewer may
+// see: all of them for an admin, those of its own volunteers for a trusted
+// organization.
+func (s *VerificationService) ListPending(ctx context.Context, userID int64, role string, offset, limit int) ([]*models.VerificationDocument, error) {
+	if models.Role(role) == models.RoleAdmin {
+		return s.verificationRepo.ListPendingDocuments(ctx, nil, offset, limit)
+	}
+
+	org, err := s.trustedOrganization(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.verificationRepo.ListPendingDocuments(ctx, &org.ID, offset, limit)
+}
+
+// Open returns the document and its file for the volunteer who uploaded it
+// or a reviewer allowed to see it. The caller must close the file.
+func (s *VerificationService) Open(ctx context.Context, id, userID int64, role string) (*models.VerificationDocument, io.ReadCloser, error) {
+	document, err := s.getDocument(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if models.Role(role) == models.RoleVolunteer {
+		volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if volunteer == nil || volunteer.ID != document.VolunteerID {
+			return nil, nil, ErrUnauthorized
+		}
+	} else if _, err := s.checkReviewer(ctx, document.VolunteerID, userID, role); err != nil {
+		return nil, nil, err
+	}
+
+	file, err := s.storage.Get(ctx, document.StorageKey)
+	if err == storage.ErrNotFound {
+		return nil, nil, ErrVerificationDocumentNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return document, file, nil
+}
+
+// Review approves a pending document at a verification level or rejects it,
+// recording who reviewed it and when. Only admins may grant the trusted
+// level.
+func (s *VerificationService) Review(ctx context.Context, id int64, input *models.ReviewVerificationDocumentInput, userID int64, role string) (*models.VerificationDocument, error) {
+	document, err := s.getDocument(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	organizationID, err := s.checkReviewer(ctx, document.VolunteerID, userID, role)
+	if err != nil {
+		return nil, err
+	}
+	if document.Status != models.VerificationDocumentPending {
+		return nil, ErrDocumentAlreadyReviewed
+	}
+
+	now := time.Now()
+	problems := make(map[string]string)
+	if input.Decision == "approve" {
+		if input.Level == "" {
+			problems["level"] = "is required to approve a document"
+		} else if input.Level == models.VerificationTrusted && models.Role(role) != models.RoleAdmin {
+			problems["level"] = "only an admin can grant trusted verification"
+		}
+		if input.ExpiresAt != nil && !input.ExpiresAt.After(now) {
+			problems["expires_at"] = "must be in the future"
+		}
+	} else if strings.TrimSpace(input.Note) == "" {
+		problems["note"] = "is required to reject a document"
+	}
+	if len(problems) > 0 {
+		return nil, &FormValidationError{Fields: problems}
+	}
+
+	document.Status = models.VerificationDocumentRejected
+	document.Level = ""
+	if input.Decision == "approve" {
+		document.Status = models.VerificationDocumentApproved
+		document.Level = input.Level
+		if input.ExpiresAt != nil {
+			document.ExpiresAt = input.ExpiresAt
+		}
+	}
+	document.ReviewNote = strings.TrimSpace(input.Note)
+	document.ReviewedBy = &userID
+	document.ReviewerOrganizationID = organizationID
+	document.ReviewedAt = &now
+	if err := s.verificationRepo.ReviewDocument(ctx, document); err != nil {
+		return nil, err
+	}
+
+	if _, _, err := s.CurrentLevel(ctx, document.VolunteerID); err != nil {
+		return nil, err
+	}
+
+	notification := &models.CreateNotificationInput{
+		Type:    models.NotificationVerificationReviewed,
+		Title:   fmt.Sprintf("Your %s was %s", strings.ReplaceAll(string(document.Type), "_", " "), document.Status),
+		Message: document.ReviewNote,
+	}
+	if _, err := s.notificationService.NotifyVolunteer(ctx, document.VolunteerID, notification); err != nil {
+		// Log error but don't fail the operation
+		// logger.Error("Failed to notify volunteer of verification review", err)
+	}
+
+	return document, nil
+}
+
+// Withdraw lets a volunteer remove one of their documents that has not been
+// reviewed yet.
+func (s *VerificationService) Withdraw(ctx context.Context, id, userID int64) error {
+	document, err := s.getDocument(ctx, id)
+	if err != nil {
+		return err
+	}
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if volunteer == nil || volunteer.ID != document.VolunteerID {
+		return ErrUnauthorized
+	}
+	if document.Status != models.VerificationDocumentPending {
+		return ErrDocumentAlreadyReviewed
+	}
+
+	if err := s.verificationRepo.DeleteDocument(ctx, id); err != nil {
+		return err
+	}
+	if err := s.storage.Delete(ctx, document.StorageKey); err != nil && err != storage.ErrNotFound {
+		// logger.Error("Failed to remove withdrawn document", err)
+	}
+	return nil
+}
+
+// CurrentLevel works out the volunteer's verification level from their
+// approved documents that have not expired, and when it lapses. The level
+// stored on the volunteer is brought up to date on the way.
+func (s *VerificationService) CurrentLevel(ctx context.Context, volunteerID int64) (models.VerificationLevel, *time.Time, error) {
+	volunteer, err := s.volunteerRepo.GetByID(ctx, volunteerID)
+	if err != nil {
+		return "", nil, err
+	}
+	if volunteer == nil {
+		return "", nil, ErrVolunteerNotFound
+	}
+	documents, err := s.verificationRepo.ListDocuments(ctx, volunteerID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	level, expiresAt := verificationLevel(documents, time.Now())
+	if level != volunteer.VerificationLevel {
+		if err := s.verificationRepo.SetVolunteerLevel(ctx, volunteerID, level); err != nil {
+			return "", nil, err
+		}
+		if err := s.cacheService.DeleteVolunteer(ctx, volunteerID); err != nil {
+			// logger.Error("Failed to invalidate volunteer cache", err)
+		}
+	}
+	return level, expiresAt, nil
+}
+
+// CheckSignupLevel fails when the event requires a higher verification level
+// than the volunteer currently holds.
+func (s *VerificationService) CheckSignupLevel(ctx context.Context, event *models.Event, volunteerID int64) error {
+	if models.VerificationNone.Meets(event.MinVerificationLevel) {
+		return nil
+	}
+
+	level, _, err := s.CurrentLevel(ctx, volunteerID)
+	if err != nil {
+		return err
+	}
+	if !level.Meets(event.MinVerificationLevel) {
+		return &VerificationLevelTooLowError{Level: level, Required: event.MinVerificationLevel}
+	}
+	return nil
+}
+
+func (s *VerificationService) status(ctx context.Context, volunteer *models.Volunteer) (*models.VerificationStatus, error) {
+	level, expiresAt, err := s.CurrentLevel(ctx, volunteer.ID)
+	if err != nil {
+		return nil, err
+	}
+	documents, err := s.verificationRepo.ListDocuments(ctx, volunteer.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.VerificationStatus{
+		VolunteerID: volunteer.ID,
+		Level:       level,
+		ExpiresAt:   expiresAt,
+		Documents:   documents,
+	}, nil
+}
+
+func (s *VerificationService) getDocument(ctx context.Context, id int64) (*models.VerificationDocument, error) {
+	document, err := s.verificationRepo.GetDocument(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if document == nil {
+		return nil, ErrVerificationDocumentNotFound
+	}
+	return document, nil
+}
+
+// checkReviewer allows admins, and trusted organizations the volunteer signed
+// up with, to see and review the volunteer's documents. It returns the
+// reviewing organization, or nil for an admin.
+func (s *VerificationService) checkReviewer(ctx context.Context, volunteerID, userID int64, role string) (*int64, error) {
+	if models.Role(role) == models.RoleAdmin {
+		return nil, nil
+	}
+	if models.Role(role) != models.RoleOrganization {
+		return nil, ErrUnauthorized
+	}
+
+	org, err := s.trustedOrganization(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := s.verificationRepo.IsOrganizationVolunteer(ctx, org.ID, volunteerID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrUnauthorized
+	}
+	return &org.ID, nil
+}
+
+func (s *VerificationService) trustedOrganization(ctx context.Context, userID int64) (*models.Organization, error) {
+	org, err := s.organizationRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil || !org.IsTrusted {
+		return nil, ErrUnauthorized
+	}
+	return org, nil
+}
+
+// verificationLevel returns the highest level granted by an approved document
+// that has not expired at t, and when that level lapses: nil when one of the
+// documents granting it never expires.
+func verificationLevel(documents []*models.VerificationDocument, t time.Time) (models.VerificationLevel, *time.Time) {
+	level := models.VerificationNone
+	var expiresAt *time.Time
+	permanent := false
+	for _, document := range documents {
+		if document.Status != models.VerificationDocumentApproved || document.Expired(t) {
+			continue
+		}
+		if !document.Level.Meets(level) {
+			continue
+		}
+		if document.Level != level {
+			level, expiresAt, permanent = document.Level, nil, false
+		}
+		if document.ExpiresAt == nil {
+			permanent = true
+		} else if expiresAt == nil || document.ExpiresAt.After(*expiresAt) {
+			expiresAt = document.ExpiresAt
+		}
+	}
+	if permanent {
+		return level, nil
+	}
+	return level, expiresAt
+}
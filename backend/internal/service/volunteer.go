@@ -27,6 +27,7 @@ type VolunteerRepository interface {
 	Update(ctx context.Context, id int64, input *models.UpdateVolunteerInput) (*models.Volunteer, error)
 	Delete(ctx context.Context, id int64) error
 	List(ctx context.Context, offset, limit int) ([]*models.Volunteer, error)
+	ListAchievements(ctx context.Context, volunteerID int64) ([]*models.Achievement, error)
 }
 
 func NewVolunteerService(volunteerRepo VolunteerRepository, userRepo repository.UserRepository, cacheService *CacheService) *VolunteerService {
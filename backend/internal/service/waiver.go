@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"volunteer-management/internal/models"
+	"volunteer-management/internal/repository"
+	"volunteer-management/pkg/pdf"
+)
+
+var (
+	ErrWaiverNotFound          = errors.New("waiver not found")
+	ErrWaiverSignatureRequired = errors.New("this event requires you to agree to and sign its waiver")
+	ErrWaiverSignatureNotFound = errors.New("waiver signature not found")
+)
+
+type WaiverService struct {
+	waiverRepo       repository.WaiverRepository
+	eventService     *EventService
+	organizationRepo repository.OrganizationRepository
+	volunteerRepo    VolunteerRepository
+}
+
+func NewWaiverService(
+	waiverRepo repository.WaiverRepository,
+	eventService *EventService,
+	organizationRepo repository.OrganizationRepository,
+	volunteerRepo VolunteerRepository) *WaiverService {
+	return &WaiverService{
+		waiverRepo:       waiverRepo,
+		eventService:     eventService,
+		organizationRepo: organizationRepo,
+		volunteerRepo:    volunteerRepo,
+	}
+}
+
+// Create stores a new waiver for the user's organization with its first version.
+func (s *WaiverService) Create(ctx context.Context, input *models.CreateWaiverInput, userID int64) (*models.Waiver, error) {
+	org, err := s.organizationRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, ErrUnauthorized
+	}
+
+	version := &models.WaiverVersion{
+		Text:     input.Text,
+		TextHash: hashWaiverText(input.Text),
+	}
+	return s.waiverRepo.Create(ctx, org.ID, input.Title, version)
+}
+
+// GetByID returns the waiver with its current version.
+func (s *WaiverService) GetByID(ctx context.Context, id int64) (*models.Waiver, error) {
+	waiver, err := s.waiverRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if waiver == nil {
+		return nil, ErrWaiverNotFound
+	}
+
+	waiver.CurrentVersion, err = s.waiverRepo.GetCurrentVersion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return waiver, nil
+}
+
+func (s *WaiverService) ListByOrganization(ctx context.Context, userID int64) ([]*models.Waiver, error) {
+	org, err := s.organizationRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, ErrUnauthorized
+	}
+
+	return s.waiverRepo.ListByOrganization(ctx, org.ID)
+}
+
+// AddVersion publishes new waiver text. Volunteers who signed an earlier
+// version have to sign again on their next signup.
+func (s *WaiverService) AddVersion(ctx context.Context, waiverID int64, input *models.CreateWaiverVersionInput, userID int64) (*models.WaiverVersion, error) {
+	if _, err := s.getOwned(ctx, waiverID, userID); err != nil {
+		return nil, err
+	}
+
+	version := &models.WaiverVersion{
+		WaiverID: waiverID,
+		Text:     input.Text,
+		TextHash: hashWaiverText(input.Text),
+	}
+	if err := s.waiverRepo.CreateVersion(ctx, version); err != nil {
+		return nil, err
+	}
+	return version, nil
+}
+
+// AttachToEvent sets or clears the waiver volunteers must sign to join the event.
+func (s *WaiverService) AttachToEvent(ctx context.Context, eventID int64, input *models.AttachWaiverInput, userID int64) (*models.Event, error) {
+	event, err := s.eventService.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	org, err := s.eventService.checkEventEditor(ctx, event, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.WaiverID != nil {
+		waiver, err := s.waiverRepo.GetByID(ctx, *input.WaiverID)
+		if err != nil {
+			return nil, err
+		}
+		if waiver == nil {
+			return nil, ErrWaiverNotFound
+		}
+		if waiver.OrganizationID != org.ID {
+			return nil, ErrUnauthorized
+		}
+	}
+
+	if err := s.eventService.eventRepo.SetWaiver(ctx, eventID, input.WaiverID); err != nil {
+		return nil, err
+	}
+	event.WaiverID = input.WaiverID
+	return event, nil
+}
+
+// GetForEvent returns the waiver attached to the event and, for volunteers,
+// whether they still need to sign its current version.
+func (s *WaiverService) GetForEvent(ctx context.Context, eventID int64, userID int64) (*models.EventWaiverResponse, error) {
+	event, err := s.eventService.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.WaiverID == nil {
+		return nil, ErrWaiverNotFound
+	}
+
+	waiver, err := s.GetByID(ctx, *event.WaiverID)
+	if err != nil {
+		return nil, err
+	}
+	response := &models.EventWaiverResponse{Waiver: waiver, Version: waiver.CurrentVersion}
+
+	volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer != nil && waiver.CurrentVersion != nil {
+		signature, err := s.waiverRepo.GetSignatureByVersionAndVolunteer(ctx, waiver.CurrentVersion.ID, volunteer.ID)
+		if err != nil {
+			return nil, err
+		}
+		response.NeedsSigning = signature == nil
+	}
+
+	return response, nil
+}
+
+// SignaturePDF renders a signed copy of the waiver. The signing volunteer,
+// the organization that owns the waiver and admins may download it.
+func (s *WaiverService) SignaturePDF(ctx context.Context, signatureID int64, userID int64, role string) ([]byte, error) {
+	signature, err := s.waiverRepo.GetSignature(ctx, signatureID)
+	if err != nil {
+		return nil, err
+	}
+	if signature == nil {
+		return nil, ErrWaiverSignatureNotFound
+	}
+
+	waiver, err := s.waiverRepo.GetByID(ctx, signature.WaiverID)
+	if err != nil {
+		return nil, err
+	}
+	if waiver == nil {
+		return nil, ErrWaiverNotFound
+	}
+
+	if err := s.checkSignatureAccess(ctx, signature, waiver, userID, role); err != nil {
+		return nil, err
+	}
+
+	version, err := s.waiverRepo.GetVersion(ctx, signature.WaiverVersionID)
+	if err != nil {
+		return nil, err
+	}
+	if version == nil {
+		return nil, ErrWaiverNotFound
+	}
+
+	doc := pdf.New()
+	doc.Heading(waiver.Title)
+	doc.Field("Version", fmt.Sprintf("%d", version.Version))
+	doc.Blank()
+	doc.Text(version.Text)
+	doc.Blank()
+	doc.Heading("Signature")
+	doc.Field("Signed by", signature.SignedName)
+	doc.Field("Signed at", signature.SignedAt.UTC().Format(time.RFC1123))
+	doc.Field("IP address", signature.IPAddress)
+	doc.Field("Volunteer ID", fmt.Sprintf("%d", signature.VolunteerID))
+	doc.Field("Event ID", fmt.Sprintf("%d", signature.EventID))
+	doc.Field("Text SHA-256", signature.TextHash)
+
+	return doc.Bytes(), nil
+}
+
+func (s *WaiverService) checkSignatureAccess(ctx context.Context, signature *models.WaiverSignature, waiver *models.Waiver, userID int64, role string) error {
+	switch models.Role(role) {
+	case models.RoleAdmin:
+		return nil
+	case models.RoleVolunteer:
+		volunteer, err := s.volunteerRepo.GetByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if volunteer != nil && volunteer.ID == signature.VolunteerID {
+			return nil
+		}
+	case models.RoleOrganization:
+		org, err := s.organizationRepo.GetByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if org != nil && org.ID == waiver.OrganizationID {
+			return nil
+		}
+	}
+	return ErrUnauthorized
+}
+
+// getOwned returns the waiver if it belongs to the organization of the user.
+func (s *WaiverService) getOwned(ctx context.Context, waiverID int64, userID int64) (*models.Waiver, error) {
+	waiver, err := s.waiverRepo.GetByID(ctx, waiverID)
+	if err != nil {
+		return nil, err
+	}
+	if waiver == nil {
+		return nil, ErrWaiverNotFound
+	}
+
+	org, err := s.organizationRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil || org.ID != waiver.OrganizationID {
+		return nil, ErrUnauthorized
+	}
+
+	return waiver, nil
+}
+
+// pendingWaiverSignature checks the event's waiver before a registration. It
+// returns the signature to record once the registration succeeds, or nil when
+// the event has no waiver or the volunteer already signed its current version.
+func pendingWaiverSignature(ctx context.Context, waiverRepo repository.WaiverRepository, event *models.Event, input *models.CreateEventRegistrationInput) (*models.WaiverSignature, error) {
+	if event.WaiverID == nil {
+		return nil, nil
+	}
+
+	version, err := waiverRepo.GetCurrentVersion(ctx, *event.WaiverID)
+	if err != nil {
+		return nil, err
+	}
+	if version == nil {
+		return nil, nil
+	}
+
+	existing, err := waiverRepo.GetSignatureByVersionAndVolunteer(ctx, version.ID, input.VolunteerID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, nil
+	}
+
+	if input.Waiver == nil || !input.Waiver.Agree || strings.TrimSpace(input.Waiver.SignedName) == "" {
+		return nil, ErrWaiverSignatureRequired
+	}
+
+	return &models.WaiverSignature{
+		WaiverID:        version.WaiverID,
+		WaiverVersionID: version.ID,
+		VolunteerID:     input.VolunteerID,
+		EventID:         event.ID,
+		SignedName:      strings.TrimSpace(input.Waiver.SignedName),
+		SignedAt:        time.Now(),
+		IPAddress:       input.SignerIP,
+		TextHash:        version.TextHash,
+	}, nil
+}
+
+func hashWaiverText(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
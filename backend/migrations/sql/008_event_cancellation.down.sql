@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_notifications_read_status;
+DROP INDEX IF EXISTS idx_notifications_user;
+DROP TABLE IF EXISTS notifications;
+
+UPDATE event_registrations SET status = 'cancelled' WHERE status = 'cancelled-by-organizer';
+ALTER TABLE event_registrations ALTER COLUMN status TYPE VARCHAR(20);
+
+ALTER TABLE events DROP COLUMN IF EXISTS cancelled_at;
+ALTER TABLE events DROP COLUMN IF EXISTS cancellation_message;
@@ -0,0 +1,25 @@
+-- Record why and when an event was cancelled
+ALTER TABLE events ADD COLUMN IF NOT EXISTS cancellation_message TEXT;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMP;
+
+-- Widen status columns to fit 'cancelled-by-organizer'
+ALTER TABLE event_registrations ALTER COLUMN status TYPE VARCHAR(30);
+ALTER TABLE IF EXISTS signups ALTER COLUMN status TYPE VARCHAR(30) USING status::text;
+
+-- Create notifications table
+CREATE TABLE IF NOT EXISTS notifications (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    message TEXT,
+    event_id INTEGER,
+    is_read BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_notification_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_notification_event FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_notifications_user ON notifications(user_id);
+CREATE INDEX idx_notifications_read_status ON notifications(user_id, is_read);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_events_pending_review;
+
+ALTER TABLE organizations DROP COLUMN IF EXISTS is_trusted;
+
+ALTER TABLE events DROP COLUMN IF EXISTS reviewed_at;
+ALTER TABLE events DROP COLUMN IF EXISTS reviewed_by;
+ALTER TABLE events DROP COLUMN IF EXISTS review_note;
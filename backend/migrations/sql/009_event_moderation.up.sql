@@ -0,0 +1,9 @@
+-- Track admin moderation of events
+ALTER TABLE events ADD COLUMN IF NOT EXISTS review_note TEXT;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS reviewed_by INTEGER REFERENCES users(id);
+ALTER TABLE events ADD COLUMN IF NOT EXISTS reviewed_at TIMESTAMP;
+
+-- Trusted organizations publish events without review
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS is_trusted BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX idx_events_pending_review ON events(updated_at) WHERE status = 'pending_review';
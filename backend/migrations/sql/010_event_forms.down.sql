@@ -0,0 +1,4 @@
+ALTER TABLE IF EXISTS signups DROP COLUMN IF EXISTS answers;
+ALTER TABLE IF EXISTS signups DROP COLUMN IF EXISTS form_id;
+
+DROP TABLE IF EXISTS event_forms;
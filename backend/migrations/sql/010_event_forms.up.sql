@@ -0,0 +1,16 @@
+-- Versioned registration questions per event
+CREATE TABLE IF NOT EXISTS event_forms (
+    id SERIAL PRIMARY KEY,
+    event_id INTEGER NOT NULL,
+    version INTEGER NOT NULL,
+    questions JSONB NOT NULL DEFAULT '[]',
+    created_by INTEGER,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_event_form_event FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE,
+    CONSTRAINT fk_event_form_user FOREIGN KEY (created_by) REFERENCES users(id),
+    CONSTRAINT uq_event_form_version UNIQUE (event_id, version)
+);
+
+-- Answers are stored with the signup alongside the form version they answer
+ALTER TABLE IF EXISTS signups ADD COLUMN IF NOT EXISTS form_id INTEGER REFERENCES event_forms(id);
+ALTER TABLE IF EXISTS signups ADD COLUMN IF NOT EXISTS answers JSONB;
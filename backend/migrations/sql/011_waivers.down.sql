@@ -0,0 +1,5 @@
+ALTER TABLE events DROP COLUMN IF EXISTS waiver_id;
+
+DROP TABLE IF EXISTS waiver_signatures;
+DROP TABLE IF EXISTS waiver_versions;
+DROP TABLE IF EXISTS waivers;
@@ -0,0 +1,43 @@
+-- Organization liability waivers
+CREATE TABLE IF NOT EXISTS waivers (
+    id SERIAL PRIMARY KEY,
+    organization_id INTEGER NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_waiver_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE
+);
+
+-- Waiver text is versioned so signatures point at the exact wording signed
+CREATE TABLE IF NOT EXISTS waiver_versions (
+    id SERIAL PRIMARY KEY,
+    waiver_id INTEGER NOT NULL,
+    version INTEGER NOT NULL,
+    text TEXT NOT NULL,
+    text_hash VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_waiver_version_waiver FOREIGN KEY (waiver_id) REFERENCES waivers(id) ON DELETE CASCADE,
+    CONSTRAINT uq_waiver_version UNIQUE (waiver_id, version)
+);
+
+CREATE TABLE IF NOT EXISTS waiver_signatures (
+    id SERIAL PRIMARY KEY,
+    waiver_id INTEGER NOT NULL,
+    waiver_version_id INTEGER NOT NULL,
+    volunteer_id INTEGER NOT NULL,
+    event_id INTEGER NOT NULL,
+    signed_name VARCHAR(255) NOT NULL,
+    signed_at TIMESTAMP NOT NULL,
+    ip_address VARCHAR(45),
+    text_hash VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_waiver_signature_waiver FOREIGN KEY (waiver_id) REFERENCES waivers(id),
+    CONSTRAINT fk_waiver_signature_version FOREIGN KEY (waiver_version_id) REFERENCES waiver_versions(id),
+    CONSTRAINT fk_waiver_signature_volunteer FOREIGN KEY (volunteer_id) REFERENCES volunteers(id) ON DELETE CASCADE,
+    CONSTRAINT fk_waiver_signature_event FOREIGN KEY (event_id) REFERENCES events(id),
+    CONSTRAINT uq_waiver_signature UNIQUE (waiver_version_id, volunteer_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_waiver_signatures_volunteer ON waiver_signatures(volunteer_id);
+
+ALTER TABLE events ADD COLUMN IF NOT EXISTS waiver_id INTEGER REFERENCES waivers(id) ON DELETE SET NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE organizations DROP COLUMN IF EXISTS cover_image;
+
+DROP TABLE IF EXISTS media;
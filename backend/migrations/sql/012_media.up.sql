@@ -0,0 +1,17 @@
+-- Uploaded images and their stored variants
+CREATE TABLE IF NOT EXISTS media (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL,
+    width INTEGER NOT NULL,
+    height INTEGER NOT NULL,
+    variants JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_media_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_media_created_at ON media(created_at);
+
+-- The organization model has a cover image that the initial schema lacked
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS cover_image VARCHAR(255);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_events_start_time;
+
+ALTER TABLE events ALTER COLUMN end_time TYPE TIMESTAMP USING end_time AT TIME ZONE 'UTC';
+ALTER TABLE events ALTER COLUMN start_time TYPE TIMESTAMP USING start_time AT TIME ZONE 'UTC';
+ALTER TABLE events ALTER COLUMN date TYPE TIMESTAMP USING date AT TIME ZONE 'UTC';
+
+ALTER TABLE events DROP COLUMN IF EXISTS time_zone;
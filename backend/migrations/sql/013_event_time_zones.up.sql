@@ -0,0 +1,20 @@
+-- Each event carries an IANA time zone; its times are stored as UTC instants
+ALTER TABLE events ADD COLUMN IF NOT EXISTS time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+ALTER TABLE events ADD COLUMN IF NOT EXISTS start_time TIMESTAMP;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS end_time TIMESTAMP;
+
+-- Existing naive timestamps were written as UTC wall-clock values, so they are
+-- converted as UTC. The events keep the UTC zone and therefore display the same
+-- local times as before until an organizer sets their real zone.
+ALTER TABLE events ALTER COLUMN date TYPE TIMESTAMPTZ USING date AT TIME ZONE 'UTC';
+ALTER TABLE events ALTER COLUMN start_time TYPE TIMESTAMPTZ USING start_time AT TIME ZONE 'UTC';
+ALTER TABLE events ALTER COLUMN end_time TYPE TIMESTAMPTZ USING end_time AT TIME ZONE 'UTC';
+
+-- Rows from before start and end times existed only have a date and a duration
+UPDATE events SET start_time = date WHERE start_time IS NULL;
+UPDATE events SET end_time = start_time + make_interval(mins => COALESCE(duration, 0)) WHERE end_time IS NULL;
+
+-- Date is the local day the event starts on, at midnight in the event's zone
+UPDATE events SET date = date_trunc('day', start_time AT TIME ZONE time_zone) AT TIME ZONE time_zone;
+
+CREATE INDEX IF NOT EXISTS idx_events_start_time ON events(start_time);
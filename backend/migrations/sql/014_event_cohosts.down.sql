@@ -0,0 +1,4 @@
+ALTER TABLE IF EXISTS signups DROP COLUMN IF EXISTS host_organization_id;
+ALTER TABLE event_registrations DROP COLUMN IF EXISTS host_organization_id;
+
+DROP TABLE IF EXISTS event_cohosts;
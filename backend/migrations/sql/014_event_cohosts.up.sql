@@ -0,0 +1,21 @@
+-- Organizations co-hosting an event alongside its primary organization
+CREATE TABLE IF NOT EXISTS event_cohosts (
+    id SERIAL PRIMARY KEY,
+    event_id INTEGER NOT NULL,
+    organization_id INTEGER NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending', -- 'pending', 'accepted', 'declined'
+    invited_by INTEGER NOT NULL,
+    responded_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_event_cohost_event FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE,
+    CONSTRAINT fk_event_cohost_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    CONSTRAINT fk_event_cohost_inviter FOREIGN KEY (invited_by) REFERENCES users(id),
+    CONSTRAINT uq_event_cohost UNIQUE (event_id, organization_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_cohosts_organization ON event_cohosts(organization_id, status);
+
+-- Credit volunteers to the host that recruited them; NULL means the primary organization
+ALTER TABLE event_registrations ADD COLUMN IF NOT EXISTS host_organization_id INTEGER REFERENCES organizations(id) ON DELETE SET NULL;
+ALTER TABLE IF EXISTS signups ADD COLUMN IF NOT EXISTS host_organization_id INTEGER REFERENCES organizations(id) ON DELETE SET NULL;
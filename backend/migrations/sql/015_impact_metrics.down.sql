@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS event_metric_values;
+DROP TABLE IF EXISTS impact_metric_definitions;
@@ -0,0 +1,60 @@
+-- Impact metrics defined by admins instead of a fixed set of event columns
+CREATE TABLE IF NOT EXISTS impact_metric_definitions (
+    id SERIAL PRIMARY KEY,
+    key VARCHAR(64) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    unit VARCHAR(50) NOT NULL,
+    aggregation VARCHAR(20) NOT NULL DEFAULT 'sum', -- 'sum', 'average', 'max', 'min'
+    categories JSONB NOT NULL DEFAULT '[]', -- event categories the metric applies to; empty means all
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS event_metric_values (
+    id SERIAL PRIMARY KEY,
+    event_id INTEGER NOT NULL,
+    metric_id INTEGER NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    recorded_by INTEGER,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_event_metric_event FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE,
+    CONSTRAINT fk_event_metric_definition FOREIGN KEY (metric_id) REFERENCES impact_metric_definitions(id) ON DELETE CASCADE,
+    CONSTRAINT fk_event_metric_recorder FOREIGN KEY (recorded_by) REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT uq_event_metric UNIQUE (event_id, metric_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_metric_values_metric ON event_metric_values(metric_id);
+
+-- The metrics events used to carry as fixed fields
+INSERT INTO impact_metric_definitions (key, name, unit, aggregation) VALUES
+    ('trees_planted', 'Trees planted', 'trees', 'sum'),
+    ('waste_collected', 'Waste collected', 'kg', 'sum'),
+    ('meals_served', 'Meals served', 'meals', 'sum'),
+    ('people_helped', 'People helped', 'people', 'sum'),
+    ('area_cleaned', 'Area cleaned', 'm²', 'sum'),
+    ('funds_raised', 'Funds raised', 'USD', 'sum'),
+    ('education_hours', 'Education hours', 'hours', 'sum'),
+    ('community_projects', 'Community projects', 'projects', 'sum')
+ON CONFLICT (key) DO NOTHING;
+
+-- Carry over values from databases that stored the old fields as a JSON column
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name = 'events' AND column_name = 'impact_metrics'
+    ) THEN
+        INSERT INTO event_metric_values (event_id, metric_id, value)
+        SELECT e.id, d.id, (m.value)::DOUBLE PRECISION
+        FROM events e
+        CROSS JOIN LATERAL jsonb_each_text(e.impact_metrics::JSONB) m
+        JOIN impact_metric_definitions d ON d.key = m.key
+        WHERE m.value ~ '^-?[0-9.]+$' AND (m.value)::DOUBLE PRECISION <> 0
+        ON CONFLICT (event_id, metric_id) DO NOTHING;
+
+        ALTER TABLE events DROP COLUMN impact_metrics;
+    END IF;
+END $$;
@@ -0,0 +1,8 @@
+ALTER TABLE organizations DROP COLUMN IF EXISTS rating_count;
+ALTER TABLE organizations DROP COLUMN IF EXISTS rating_average;
+ALTER TABLE events DROP COLUMN IF EXISTS rating_count;
+ALTER TABLE events DROP COLUMN IF EXISTS rating_average;
+
+DROP TABLE IF EXISTS volunteer_ratings;
+DROP TABLE IF EXISTS feedback_reports;
+DROP TABLE IF EXISTS event_feedbacks;
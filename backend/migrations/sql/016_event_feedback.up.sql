@@ -0,0 +1,62 @@
+-- Volunteers' ratings of completed events and of the organization that hosted them
+CREATE TABLE IF NOT EXISTS event_feedbacks (
+    id SERIAL PRIMARY KEY,
+    event_id INTEGER NOT NULL,
+    volunteer_id INTEGER NOT NULL,
+    organization_id INTEGER NOT NULL, -- the host the volunteer was attributed to
+    event_rating SMALLINT NOT NULL CHECK (event_rating BETWEEN 1 AND 5),
+    organization_rating SMALLINT NOT NULL CHECK (organization_rating BETWEEN 1 AND 5),
+    comment TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'visible', -- 'visible', 'flagged', 'hidden'
+    report_count INTEGER NOT NULL DEFAULT 0,
+    moderation_note TEXT NOT NULL DEFAULT '',
+    moderated_by INTEGER,
+    moderated_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_feedback_event FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE,
+    CONSTRAINT fk_feedback_volunteer FOREIGN KEY (volunteer_id) REFERENCES volunteers(id) ON DELETE CASCADE,
+    CONSTRAINT fk_feedback_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    CONSTRAINT fk_feedback_moderator FOREIGN KEY (moderated_by) REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT uq_feedback_event_volunteer UNIQUE (event_id, volunteer_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_feedbacks_organization ON event_feedbacks(organization_id);
+CREATE INDEX IF NOT EXISTS idx_event_feedbacks_status ON event_feedbacks(status);
+
+-- One report per user and feedback
+CREATE TABLE IF NOT EXISTS feedback_reports (
+    feedback_id INTEGER NOT NULL REFERENCES event_feedbacks(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (feedback_id, user_id)
+);
+
+-- Organizations' private ratings of volunteers; each host of an event rates separately
+CREATE TABLE IF NOT EXISTS volunteer_ratings (
+    id SERIAL PRIMARY KEY,
+    event_id INTEGER NOT NULL,
+    volunteer_id INTEGER NOT NULL,
+    organization_id INTEGER NOT NULL,
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    note TEXT NOT NULL DEFAULT '',
+    rated_by INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_volunteer_rating_event FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE,
+    CONSTRAINT fk_volunteer_rating_volunteer FOREIGN KEY (volunteer_id) REFERENCES volunteers(id) ON DELETE CASCADE,
+    CONSTRAINT fk_volunteer_rating_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    CONSTRAINT fk_volunteer_rating_rater FOREIGN KEY (rated_by) REFERENCES users(id),
+    CONSTRAINT uq_volunteer_rating UNIQUE (event_id, volunteer_id, organization_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_volunteer_ratings_volunteer ON volunteer_ratings(volunteer_id);
+
+-- Denormalized averages shown on events and organization profiles
+ALTER TABLE events ADD COLUMN IF NOT EXISTS rating_average DECIMAL(3,2) NOT NULL DEFAULT 0;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS rating_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS rating_average DECIMAL(3,2) NOT NULL DEFAULT 0;
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS rating_count INTEGER NOT NULL DEFAULT 0;
+
+-- event_registrations.feedback is superseded by event_feedbacks; the column is
+-- kept so comments written before ratings existed are not lost
@@ -0,0 +1,7 @@
+ALTER TABLE IF EXISTS signups DROP COLUMN IF EXISTS group_signup_id;
+
+DROP TABLE IF EXISTS group_signup_members;
+DROP TABLE IF EXISTS group_signups;
+DROP TABLE IF EXISTS group_invitations;
+DROP TABLE IF EXISTS group_members;
+DROP TABLE IF EXISTS volunteer_groups;
@@ -0,0 +1,67 @@
+-- Teams such as company groups or school clubs whose leaders sign members up together
+CREATE TABLE IF NOT EXISTS volunteer_groups (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    kind VARCHAR(20) NOT NULL DEFAULT 'other', -- 'corporate', 'school', 'community', 'other'
+    created_by INTEGER NOT NULL REFERENCES volunteers(id),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS group_members (
+    id SERIAL PRIMARY KEY,
+    group_id INTEGER NOT NULL REFERENCES volunteer_groups(id) ON DELETE CASCADE,
+    volunteer_id INTEGER NOT NULL REFERENCES volunteers(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL DEFAULT 'member', -- 'leader', 'member'
+    joined_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_group_member UNIQUE (group_id, volunteer_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_group_members_volunteer ON group_members(volunteer_id);
+
+-- Invitations by email for people who may not have an account yet; only a hash of the token is kept
+CREATE TABLE IF NOT EXISTS group_invitations (
+    id SERIAL PRIMARY KEY,
+    group_id INTEGER NOT NULL REFERENCES volunteer_groups(id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending', -- 'pending', 'accepted', 'revoked'
+    invited_by INTEGER NOT NULL REFERENCES volunteers(id),
+    accepted_by INTEGER REFERENCES volunteers(id) ON DELETE SET NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_group_invitations_group ON group_invitations(group_id);
+
+CREATE TABLE IF NOT EXISTS group_signups (
+    id SERIAL PRIMARY KEY,
+    group_id INTEGER NOT NULL REFERENCES volunteer_groups(id) ON DELETE CASCADE,
+    event_id INTEGER NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    mode VARCHAR(20) NOT NULL, -- 'all_or_nothing', 'partial'
+    host_organization_id INTEGER REFERENCES organizations(id) ON DELETE SET NULL,
+    requested_count INTEGER NOT NULL,
+    signed_up_count INTEGER NOT NULL DEFAULT 0,
+    created_by INTEGER NOT NULL REFERENCES volunteers(id),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_group_signups_group ON group_signups(group_id);
+CREATE INDEX IF NOT EXISTS idx_group_signups_event ON group_signups(event_id);
+
+-- Outcome of a group signup per member, and the attendance the host recorded
+CREATE TABLE IF NOT EXISTS group_signup_members (
+    id SERIAL PRIMARY KEY,
+    group_signup_id INTEGER NOT NULL REFERENCES group_signups(id) ON DELETE CASCADE,
+    volunteer_id INTEGER NOT NULL REFERENCES volunteers(id) ON DELETE CASCADE,
+    signup_id INTEGER REFERENCES signups(id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL, -- 'signed_up', 'skipped'
+    reason TEXT,
+    attendance VARCHAR(20), -- 'attended', 'no-show'
+    hours_logged DECIMAL(5,2) NOT NULL DEFAULT 0,
+    CONSTRAINT uq_group_signup_member UNIQUE (group_signup_id, volunteer_id)
+);
+
+ALTER TABLE IF EXISTS signups ADD COLUMN IF NOT EXISTS group_signup_id INTEGER REFERENCES group_signups(id) ON DELETE SET NULL;
@@ -0,0 +1,4 @@
+ALTER TABLE volunteers DROP COLUMN IF EXISTS total_hours;
+
+DROP TABLE IF EXISTS hours_changes;
+DROP TABLE IF EXISTS hour_submissions;
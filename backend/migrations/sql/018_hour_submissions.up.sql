@@ -0,0 +1,55 @@
+-- Hours claimed by volunteers and reviewed by the host; only approved hours count
+CREATE TABLE IF NOT EXISTS hour_submissions (
+    id SERIAL PRIMARY KEY,
+    event_id INTEGER NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    volunteer_id INTEGER NOT NULL REFERENCES volunteers(id) ON DELETE CASCADE,
+    organization_id INTEGER NOT NULL REFERENCES organizations(id) ON DELETE CASCADE, -- host credited with the hours
+    hours DECIMAL(5,2) NOT NULL,
+    approved_hours DECIMAL(5,2),
+    status VARCHAR(20) NOT NULL DEFAULT 'submitted', -- 'submitted', 'approved', 'adjusted', 'rejected', 'disputed'
+    note TEXT,
+    review_reason TEXT,
+    reviewed_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMP,
+    dispute_reason TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_hour_submission UNIQUE (event_id, volunteer_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_hour_submissions_volunteer ON hour_submissions(volunteer_id);
+CREATE INDEX IF NOT EXISTS idx_hour_submissions_organization_status ON hour_submissions(organization_id, status);
+
+-- Append-only history of every submission, review and dispute
+CREATE TABLE IF NOT EXISTS hours_changes (
+    id SERIAL PRIMARY KEY,
+    submission_id INTEGER NOT NULL REFERENCES hour_submissions(id) ON DELETE CASCADE,
+    action VARCHAR(20) NOT NULL, -- 'submitted', 'approved', 'adjusted', 'rejected', 'disputed', 'recorded'
+    hours DECIMAL(5,2),
+    comment TEXT,
+    actor_id INTEGER NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_hours_changes_submission ON hours_changes(submission_id);
+
+-- Hours already logged on registrations were entered by hosts; keep them as approved
+INSERT INTO hour_submissions (event_id, volunteer_id, organization_id, hours, approved_hours, status, created_at, updated_at)
+SELECT er.event_id, er.volunteer_id, COALESCE(er.host_organization_id, e.organization_id),
+       er.hours_logged, er.hours_logged, 'approved', er.updated_at, er.updated_at
+FROM event_registrations er
+JOIN events e ON e.id = er.event_id
+WHERE er.hours_logged > 0
+ON CONFLICT (event_id, volunteer_id) DO NOTHING;
+
+-- Denormalized sum of approved hours, kept in step by the hours workflow
+ALTER TABLE volunteers ADD COLUMN IF NOT EXISTS total_hours DECIMAL(7,2) NOT NULL DEFAULT 0;
+
+UPDATE volunteers v
+SET total_hours = h.total
+FROM (
+    SELECT volunteer_id, SUM(hours_logged) AS total
+    FROM event_registrations
+    GROUP BY volunteer_id
+) h
+WHERE h.volunteer_id = v.id AND h.total IS NOT NULL;
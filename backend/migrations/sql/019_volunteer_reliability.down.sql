@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS reliability_appeals;
+DROP TABLE IF EXISTS reliability_resets;
+
+ALTER TABLE organizations DROP COLUMN IF EXISTS approval_below_score;
+ALTER TABLE organizations DROP COLUMN IF EXISTS min_reliability_score;
+
+ALTER TABLE IF EXISTS signups DROP COLUMN IF EXISTS cancelled_at;
+ALTER TABLE event_registrations DROP COLUMN IF EXISTS cancelled_at;
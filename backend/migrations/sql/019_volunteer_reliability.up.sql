@@ -0,0 +1,43 @@
+-- When a commitment was cancelled, to tell late cancellations apart
+ALTER TABLE event_registrations ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMP;
+UPDATE event_registrations SET cancelled_at = updated_at WHERE status = 'cancelled' AND cancelled_at IS NULL;
+
+ALTER TABLE IF EXISTS signups ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMP;
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.tables WHERE table_name = 'signups') THEN
+        UPDATE signups SET cancelled_at = updated_at WHERE status = 'cancelled' AND cancelled_at IS NULL;
+    END IF;
+END $$;
+
+-- Organizations' signup policies; NULL means no requirement
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS min_reliability_score INTEGER;
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS approval_below_score INTEGER;
+
+-- Admin resets; only events after the latest reset count toward the score
+CREATE TABLE IF NOT EXISTS reliability_resets (
+    id SERIAL PRIMARY KEY,
+    volunteer_id INTEGER NOT NULL REFERENCES volunteers(id) ON DELETE CASCADE,
+    reason TEXT NOT NULL,
+    reset_by INTEGER NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_reliability_resets_volunteer ON reliability_resets(volunteer_id, created_at);
+
+-- Volunteers' appeals of a no-show or late cancellation; accepted appeals excuse the event
+CREATE TABLE IF NOT EXISTS reliability_appeals (
+    id SERIAL PRIMARY KEY,
+    volunteer_id INTEGER NOT NULL REFERENCES volunteers(id) ON DELETE CASCADE,
+    event_id INTEGER NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    reason TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending', -- 'pending', 'accepted', 'rejected'
+    resolution_note TEXT,
+    resolved_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    resolved_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_reliability_appeal UNIQUE (volunteer_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reliability_appeals_status ON reliability_appeals(status);
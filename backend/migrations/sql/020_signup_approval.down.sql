@@ -0,0 +1,5 @@
+ALTER TABLE IF EXISTS signups DROP COLUMN IF EXISTS decided_at;
+ALTER TABLE IF EXISTS signups DROP COLUMN IF EXISTS decided_by;
+ALTER TABLE IF EXISTS signups DROP COLUMN IF EXISTS decision_message;
+
+ALTER TABLE events DROP COLUMN IF EXISTS signup_approval;
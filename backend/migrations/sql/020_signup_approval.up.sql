@@ -0,0 +1,8 @@
+-- Whether an event's signups are confirmed right away or wait for a coordinator
+ALTER TABLE events ADD COLUMN IF NOT EXISTS signup_approval VARCHAR(20) NOT NULL DEFAULT 'auto'
+    CHECK (signup_approval IN ('auto', 'manual'));
+
+-- The coordinator's decision on a pending signup
+ALTER TABLE IF EXISTS signups ADD COLUMN IF NOT EXISTS decision_message TEXT;
+ALTER TABLE IF EXISTS signups ADD COLUMN IF NOT EXISTS decided_by INTEGER REFERENCES users(id);
+ALTER TABLE IF EXISTS signups ADD COLUMN IF NOT EXISTS decided_at TIMESTAMP;
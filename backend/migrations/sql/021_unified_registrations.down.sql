@@ -0,0 +1,45 @@
+-- Registrations that went through signups are copied back into a signups
+-- table; attendance stays on event_registrations
+CREATE TABLE IF NOT EXISTS signups (
+    id SERIAL PRIMARY KEY,
+    volunteer_id INTEGER NOT NULL REFERENCES volunteers(id),
+    event_id INTEGER NOT NULL REFERENCES events(id),
+    status VARCHAR(30) NOT NULL,
+    host_organization_id INTEGER REFERENCES organizations(id) ON DELETE SET NULL,
+    group_signup_id INTEGER REFERENCES group_signups(id) ON DELETE SET NULL,
+    form_id INTEGER REFERENCES event_forms(id),
+    answers JSONB,
+    decision_message TEXT,
+    decided_by INTEGER REFERENCES users(id),
+    decided_at TIMESTAMP,
+    cancelled_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO signups
+    (volunteer_id, event_id, status, host_organization_id, group_signup_id, form_id, answers,
+     decision_message, decided_by, decided_at, cancelled_at, created_at, updated_at)
+SELECT volunteer_id, event_id,
+    CASE status WHEN 'registered' THEN 'confirmed' ELSE status END,
+    host_organization_id, group_signup_id, form_id, answers,
+    decision_message, decided_by, decided_at, cancelled_at, created_at, updated_at
+FROM event_registrations
+WHERE status IN ('pending', 'registered', 'declined', 'cancelled', 'cancelled-by-organizer');
+
+ALTER TABLE group_signup_members ADD COLUMN IF NOT EXISTS signup_id INTEGER REFERENCES signups(id) ON DELETE SET NULL;
+UPDATE group_signup_members gm
+SET signup_id = s.id
+FROM event_registrations er
+JOIN signups s ON s.event_id = er.event_id AND s.volunteer_id = er.volunteer_id
+WHERE er.id = gm.registration_id;
+ALTER TABLE group_signup_members DROP COLUMN IF EXISTS registration_id;
+
+DROP INDEX IF EXISTS idx_registrations_event_status;
+
+ALTER TABLE event_registrations DROP COLUMN IF EXISTS decided_at;
+ALTER TABLE event_registrations DROP COLUMN IF EXISTS decided_by;
+ALTER TABLE event_registrations DROP COLUMN IF EXISTS decision_message;
+ALTER TABLE event_registrations DROP COLUMN IF EXISTS answers;
+ALTER TABLE event_registrations DROP COLUMN IF EXISTS form_id;
+ALTER TABLE event_registrations DROP COLUMN IF EXISTS group_signup_id;
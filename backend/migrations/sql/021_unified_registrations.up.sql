@@ -0,0 +1,61 @@
+-- Signups and event registrations become one record per volunteer and event,
+-- kept in event_registrations. Signup IDs are not carried over: a signup's
+-- group member and API clients now see the registration's ID.
+ALTER TABLE event_registrations ALTER COLUMN status TYPE VARCHAR(30);
+ALTER TABLE event_registrations ADD COLUMN IF NOT EXISTS registration_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE event_registrations ADD COLUMN IF NOT EXISTS group_signup_id INTEGER REFERENCES group_signups(id) ON DELETE SET NULL;
+ALTER TABLE event_registrations ADD COLUMN IF NOT EXISTS form_id INTEGER REFERENCES event_forms(id);
+ALTER TABLE event_registrations ADD COLUMN IF NOT EXISTS answers JSONB;
+ALTER TABLE event_registrations ADD COLUMN IF NOT EXISTS decision_message TEXT;
+ALTER TABLE event_registrations ADD COLUMN IF NOT EXISTS decided_by INTEGER REFERENCES users(id);
+ALTER TABLE event_registrations ADD COLUMN IF NOT EXISTS decided_at TIMESTAMP;
+
+ALTER TABLE group_signup_members ADD COLUMN IF NOT EXISTS registration_id INTEGER REFERENCES event_registrations(id) ON DELETE SET NULL;
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.tables WHERE table_name = 'signups') THEN
+        -- A confirmed signup is a registration; an existing registration keeps
+        -- its attendance and takes what only the signup recorded
+        INSERT INTO event_registrations
+            (event_id, volunteer_id, status, host_organization_id, group_signup_id, form_id, answers,
+             decision_message, decided_by, decided_at, cancelled_at, registration_date, created_at, updated_at)
+        SELECT DISTINCT ON (s.event_id, s.volunteer_id)
+            s.event_id, s.volunteer_id,
+            CASE s.status WHEN 'confirmed' THEN 'registered' ELSE s.status END,
+            s.host_organization_id, s.group_signup_id, s.form_id, s.answers,
+            s.decision_message, s.decided_by, s.decided_at, s.cancelled_at, s.created_at, s.created_at, s.updated_at
+        FROM signups s
+        ORDER BY s.event_id, s.volunteer_id, s.updated_at DESC
+        ON CONFLICT (event_id, volunteer_id) DO UPDATE
+        SET status = CASE
+                WHEN event_registrations.status IN ('registered', 'attended', 'no-show') THEN event_registrations.status
+                ELSE EXCLUDED.status END,
+            host_organization_id = COALESCE(event_registrations.host_organization_id, EXCLUDED.host_organization_id),
+            group_signup_id = EXCLUDED.group_signup_id,
+            form_id = EXCLUDED.form_id,
+            answers = EXCLUDED.answers,
+            decision_message = EXCLUDED.decision_message,
+            decided_by = EXCLUDED.decided_by,
+            decided_at = EXCLUDED.decided_at,
+            registration_date = LEAST(event_registrations.registration_date, EXCLUDED.registration_date);
+
+        UPDATE group_signup_members gm
+        SET registration_id = er.id
+        FROM signups s
+        JOIN event_registrations er ON er.event_id = s.event_id AND er.volunteer_id = s.volunteer_id
+        WHERE s.id = gm.signup_id;
+
+        ALTER TABLE group_signup_members DROP COLUMN IF EXISTS signup_id;
+        DROP TABLE signups;
+    END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS idx_registrations_event_status ON event_registrations(event_id, status);
+
+-- The seat count now follows registrations alone
+ALTER TABLE events ADD COLUMN IF NOT EXISTS volunteers_registered INTEGER NOT NULL DEFAULT 0;
+UPDATE events e SET volunteers_registered = (
+    SELECT COUNT(*) FROM event_registrations er
+    WHERE er.event_id = e.id AND er.status IN ('registered', 'attended', 'no-show')
+);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS availability_blackouts;
+DROP TABLE IF EXISTS availability_windows;
+
+ALTER TABLE volunteers DROP COLUMN IF EXISTS time_zone;
+ALTER TABLE volunteers ALTER COLUMN availability TYPE TEXT[] USING string_to_array(availability, ', ');
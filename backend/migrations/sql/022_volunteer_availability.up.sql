@@ -0,0 +1,39 @@
+-- The profile's availability is free text; the array type never matched it
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns
+               WHERE table_name = 'volunteers' AND column_name = 'availability' AND data_type = 'ARRAY') THEN
+        ALTER TABLE volunteers ALTER COLUMN availability TYPE TEXT USING array_to_string(availability, ', ');
+    END IF;
+END $$;
+
+-- Weekly windows are read on the volunteer's wall clock
+ALTER TABLE volunteers ADD COLUMN IF NOT EXISTS time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+-- Recurring weekly periods a volunteer is free; 'HH:MM' compares in order
+CREATE TABLE IF NOT EXISTS availability_windows (
+    id SERIAL PRIMARY KEY,
+    volunteer_id INTEGER NOT NULL REFERENCES volunteers(id) ON DELETE CASCADE,
+    weekday SMALLINT NOT NULL CHECK (weekday BETWEEN 0 AND 6), -- 0 is Sunday
+    start_time VARCHAR(5) NOT NULL,
+    end_time VARCHAR(5) NOT NULL, -- '24:00' is midnight at the end of the day
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (start_time < end_time)
+);
+
+CREATE INDEX IF NOT EXISTS idx_availability_windows_volunteer ON availability_windows(volunteer_id, weekday);
+
+-- Days a volunteer is away, inclusive
+CREATE TABLE IF NOT EXISTS availability_blackouts (
+    id SERIAL PRIMARY KEY,
+    volunteer_id INTEGER NOT NULL REFERENCES volunteers(id) ON DELETE CASCADE,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (start_date <= end_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_availability_blackouts_volunteer ON availability_blackouts(volunteer_id, end_date);
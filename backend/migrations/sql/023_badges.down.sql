@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_achievements_volunteer;
+DROP INDEX IF EXISTS uq_achievements_badge;
+ALTER TABLE IF EXISTS achievements DROP COLUMN IF EXISTS organization_id;
+ALTER TABLE IF EXISTS achievements DROP COLUMN IF EXISTS badge_id;
+
+DROP TABLE IF EXISTS badges;
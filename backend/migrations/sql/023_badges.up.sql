@@ -0,0 +1,35 @@
+-- Admin-defined rules that award achievements
+CREATE TABLE IF NOT EXISTS badges (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL,
+    icon VARCHAR(100),
+    rule_type VARCHAR(30) NOT NULL
+        CHECK (rule_type IN ('total_hours', 'category_events', 'consecutive_months', 'new_organization')),
+    threshold DECIMAL(8,2) NOT NULL DEFAULT 0,
+    category VARCHAR(100), -- category_events
+    organization_id INTEGER REFERENCES organizations(id) ON DELETE CASCADE, -- new_organization, optional
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by INTEGER NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS achievements (
+    id SERIAL PRIMARY KEY,
+    volunteer_id INTEGER NOT NULL REFERENCES volunteers(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    icon VARCHAR(100),
+    awarded_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Badges awarded per organization keep the organization they were earned with
+ALTER TABLE achievements ADD COLUMN IF NOT EXISTS badge_id INTEGER REFERENCES badges(id) ON DELETE SET NULL;
+ALTER TABLE achievements ADD COLUMN IF NOT EXISTS organization_id INTEGER REFERENCES organizations(id) ON DELETE SET NULL;
+
+-- A badge is awarded once per volunteer, or once per organization
+CREATE UNIQUE INDEX IF NOT EXISTS uq_achievements_badge
+    ON achievements(volunteer_id, badge_id, COALESCE(organization_id, 0));
+CREATE INDEX IF NOT EXISTS idx_achievements_volunteer ON achievements(volunteer_id, awarded_date);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_volunteer_levels_rules;
+DROP TABLE IF EXISTS volunteer_levels;
@@ -0,0 +1,11 @@
+-- Each volunteer's level as of the XP rules it was computed under, so
+-- level-ups can be detected and stale levels recomputed after a rule change
+CREATE TABLE IF NOT EXISTS volunteer_levels (
+    volunteer_id INTEGER PRIMARY KEY REFERENCES volunteers(id) ON DELETE CASCADE,
+    level INTEGER NOT NULL DEFAULT 1,
+    xp INTEGER NOT NULL DEFAULT 0,
+    rules_version VARCHAR(64) NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_volunteer_levels_rules ON volunteer_levels(rules_version);
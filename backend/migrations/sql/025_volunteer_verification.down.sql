@@ -0,0 +1,5 @@
+ALTER TABLE IF EXISTS events DROP COLUMN IF EXISTS min_verification_level;
+
+DROP INDEX IF EXISTS idx_verification_documents_pending;
+DROP INDEX IF EXISTS idx_verification_documents_volunteer;
+DROP TABLE IF EXISTS verification_documents;
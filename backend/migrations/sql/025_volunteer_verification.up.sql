@@ -0,0 +1,31 @@
+-- Verification levels are granted by reviewing documents, not set by volunteers
+ALTER TABLE volunteers ADD COLUMN IF NOT EXISTS verification_level VARCHAR(20) NOT NULL DEFAULT 'none';
+
+-- ID documents, background checks and certifications kept in private storage
+CREATE TABLE IF NOT EXISTS verification_documents (
+    id SERIAL PRIMARY KEY,
+    volunteer_id INTEGER NOT NULL REFERENCES volunteers(id) ON DELETE CASCADE,
+    type VARCHAR(30) NOT NULL
+        CHECK (type IN ('id_document', 'background_check', 'certification')),
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'approved', 'rejected')),
+    level VARCHAR(20) NOT NULL DEFAULT '', -- basic, verified or trusted, granted on approval
+    expires_at TIMESTAMP,
+    review_note TEXT,
+    reviewed_by INTEGER REFERENCES users(id),
+    reviewer_organization_id INTEGER REFERENCES organizations(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_verification_documents_volunteer ON verification_documents(volunteer_id);
+CREATE INDEX IF NOT EXISTS idx_verification_documents_pending ON verification_documents(created_at) WHERE status = 'pending';
+
+-- Events may require a minimum verification level at signup
+ALTER TABLE events ADD COLUMN IF NOT EXISTS min_verification_level VARCHAR(20) NOT NULL DEFAULT 'none'
+    CHECK (min_verification_level IN ('none', 'basic', 'verified', 'trusted'));
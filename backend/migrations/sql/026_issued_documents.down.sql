@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_issued_documents_volunteer;
+DROP TABLE IF EXISTS issued_documents;
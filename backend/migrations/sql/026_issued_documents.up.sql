@@ -0,0 +1,18 @@
+-- Transcripts and certificates as issued, looked up by verification code
+CREATE TABLE IF NOT EXISTS issued_documents (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(19) NOT NULL UNIQUE,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('transcript', 'certificate')),
+    volunteer_id INTEGER NOT NULL REFERENCES volunteers(id) ON DELETE CASCADE,
+    volunteer_name VARCHAR(255) NOT NULL,
+    event_id INTEGER REFERENCES events(id) ON DELETE SET NULL, -- certificates
+    organization_id INTEGER REFERENCES organizations(id) ON DELETE SET NULL, -- certificates
+    total_hours DOUBLE PRECISION NOT NULL, -- exactly as signed
+    entries JSONB NOT NULL,
+    signature VARCHAR(64) NOT NULL,
+    content_hash VARCHAR(64) NOT NULL,
+    issued_by INTEGER NOT NULL REFERENCES users(id),
+    issued_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_issued_documents_volunteer ON issued_documents(volunteer_id, issued_at);
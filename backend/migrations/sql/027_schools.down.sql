@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_school_students_volunteer;
+DROP TABLE IF EXISTS school_students;
+DROP INDEX IF EXISTS idx_school_terms_school;
+DROP TABLE IF EXISTS school_terms;
+DROP TABLE IF EXISTS schools;
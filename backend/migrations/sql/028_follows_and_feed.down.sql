@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_notifications_user_type;
+DROP INDEX IF EXISTS idx_announcements_organization;
+DROP TABLE IF EXISTS announcements;
+ALTER TABLE IF EXISTS organizations DROP COLUMN IF EXISTS follower_count;
+DROP INDEX IF EXISTS idx_organization_follows_volunteer;
+DROP TABLE IF EXISTS organization_follows;
@@ -0,0 +1,29 @@
+-- Volunteers following organizations; share_profile lets the organization see them
+CREATE TABLE IF NOT EXISTS organization_follows (
+    organization_id INTEGER NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    volunteer_id INTEGER NOT NULL REFERENCES volunteers(id) ON DELETE CASCADE,
+    share_profile BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (organization_id, volunteer_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_follows_volunteer ON organization_follows(volunteer_id);
+
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS follower_count INTEGER NOT NULL DEFAULT 0;
+
+-- Announcements from an organization to its followers, or from admins to
+-- everyone when organization_id is NULL
+CREATE TABLE IF NOT EXISTS announcements (
+    id SERIAL PRIMARY KEY,
+    organization_id INTEGER REFERENCES organizations(id) ON DELETE CASCADE,
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    created_by INTEGER NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_announcements_organization ON announcements(organization_id, created_at);
+
+-- Level ups are read back from notifications for the feed
+CREATE INDEX IF NOT EXISTS idx_notifications_user_type ON notifications(user_id, type, created_at);
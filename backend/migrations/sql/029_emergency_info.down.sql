@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_emergency_info_accesses_volunteer;
+DROP TABLE IF EXISTS emergency_info_accesses;
+DROP TABLE IF EXISTS emergency_info_records;
@@ -0,0 +1,24 @@
+-- Volunteers' emergency contact and medical notes, encrypted by the
+-- application: ciphertext is sealed with a per-row data key, stored wrapped
+-- with the master key named by key_id
+CREATE TABLE IF NOT EXISTS emergency_info_records (
+    volunteer_id INTEGER PRIMARY KEY REFERENCES volunteers(id) ON DELETE CASCADE,
+    key_id VARCHAR(50) NOT NULL,
+    wrapped_key BYTEA NOT NULL,
+    ciphertext BYTEA NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Every time a coordinator read a volunteer's emergency info
+CREATE TABLE IF NOT EXISTS emergency_info_accesses (
+    id SERIAL PRIMARY KEY,
+    volunteer_id INTEGER NOT NULL REFERENCES volunteers(id) ON DELETE CASCADE,
+    event_id INTEGER NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    organization_id INTEGER NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    accessed_by INTEGER NOT NULL REFERENCES users(id),
+    ip_address VARCHAR(45),
+    accessed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_emergency_info_accesses_volunteer ON emergency_info_accesses(volunteer_id, accessed_at);
@@ -7,9 +7,9 @@ import (
 
 const (
 	// Cache key prefixes
-	EventPrefix     = "event:"
-	VolunteerPrefix = "volunteer:"
-	SignupPrefix    = "signup:"
+	EventPrefix        = "event:"
+	VolunteerPrefix    = "volunteer:"
+	RegistrationPrefix = "registration:"
 
 	// Default TTLs
 	DefaultTTL     = 15 * time.Minute
@@ -28,8 +28,8 @@ func VolunteerKey(id int64) string {
 	return fmt.Sprintf("%s%d", VolunteerPrefix, id)
 }
 
-func SignupKey(id int64) string {
-	return fmt.Sprintf("%s%d", SignupPrefix, id)
+func RegistrationKey(id int64) string {
+	return fmt.Sprintf("%s%d", RegistrationPrefix, id)
 }
 
 func EventListKey(page, limit int) string {
@@ -48,10 +48,10 @@ func VolunteerListKey(page, limit int) string {
 	return fmt.Sprintf("%slist:%d:%d", VolunteerPrefix, page, limit)
 }
 
-func SignupsByEventKey(eventID int64) string {
-	return fmt.Sprintf("%sevent:%d", SignupPrefix, eventID)
+func RegistrationsByEventKey(eventID int64) string {
+	return fmt.Sprintf("%sevent:%d", RegistrationPrefix, eventID)
 }
 
-func SignupsByVolunteerKey(volunteerID int64) string {
-	return fmt.Sprintf("%svolunteer:%d", SignupPrefix, volunteerID)
+func RegistrationsByVolunteerKey(volunteerID int64) string {
+	return fmt.Sprintf("%svolunteer:%d", RegistrationPrefix, volunteerID)
 }
@@ -0,0 +1,122 @@
+// Package envelope encrypts small records with envelope encryption: every
+// record is sealed with its own random data key, and the data key is stored
+// alongside it wrapped (encrypted) with a master key. Rotating the master key
+// only means re-wrapping data keys, and a leaked data key exposes one record.
+package envelope
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+const keySize = 32 // AES-256
+
+var (
+	ErrNoKey      = errors.New("envelope: no master key configured")
+	ErrInvalidKey = errors.New("envelope: master key must be 32 bytes, base64 encoded")
+	ErrUnknownKey = errors.New("envelope: record was sealed with a different master key")
+	ErrCorrupt    = errors.New("envelope: record cannot be decrypted")
+)
+
+// Sealed is an encrypted record as stored. Both byte slices start with their
+// GCM nonce.
+type Sealed struct {
+	KeyID      string
+	WrappedKey []byte
+	Ciphertext []byte
+}
+
+// Sealer seals and opens records under one master key.
+type Sealer struct {
+	keyID  string
+	master cipher.AEAD
+}
+
+// New returns a sealer for the base64-encoded master key. keyID is stored
+// with each record so records sealed under an older key can be recognized.
+func New(keyID, encodedKey string) (*Sealer, error) {
+	if encodedKey == "" {
+		return nil, ErrNoKey
+	}
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil || len(key) != keySize {
+		return nil, ErrInvalidKey
+	}
+	master, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	return &Sealer{keyID: keyID, master: master}, nil
+}
+
+// Seal encrypts plaintext under a new data key. additionalData is
+// authenticated but not encrypted; pass the same value to Open, such as the
+// ID of the record's owner, so a ciphertext cannot be moved to another row.
+func (s *Sealer) Seal(plaintext, additionalData []byte) (*Sealed, error) {
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	data, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := seal(data, plaintext, additionalData)
+	if err != nil {
+		return nil, err
+	}
+	wrappedKey, err := seal(s.master, dataKey, []byte(s.keyID))
+	if err != nil {
+		return nil, err
+	}
+	return &Sealed{KeyID: s.keyID, WrappedKey: wrappedKey, Ciphertext: ciphertext}, nil
+}
+
+// Open unwraps the record's data key and decrypts it.
+func (s *Sealer) Open(sealed *Sealed, additionalData []byte) ([]byte, error) {
+	if sealed.KeyID != s.keyID {
+		return nil, ErrUnknownKey
+	}
+	dataKey, err := open(s.master, sealed.WrappedKey, []byte(sealed.KeyID))
+	if err != nil {
+		return nil, err
+	}
+	data, err := newGCM(dataKey)
+	if err != nil {
+		return nil, ErrCorrupt
+	}
+	return open(data, sealed.Ciphertext, additionalData)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("envelope: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(aead cipher.AEAD, sealed, additionalData []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrCorrupt
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, ErrCorrupt
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,141 @@
+// Package imaging decodes uploaded images, normalizes their orientation and
+// produces resized, metadata-free copies using only the standard library.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+)
+
+const jpegQuality = 85
+
+var (
+	ErrUnsupportedFormat = errors.New("imaging: unsupported image format")
+	ErrTooManyPixels     = errors.New("imaging: image dimensions are too large")
+)
+
+// Image is a decoded upload. Re-encoding it drops EXIF and any other metadata
+// the original file carried.
+type Image struct {
+	image.Image
+	Format string // "jpeg", "png" or "gif"
+}
+
+// Decode reads a JPEG, PNG or GIF. JPEGs are rotated according to their EXIF
+// orientation so that stripping the metadata does not turn them sideways.
+// Images declaring more than maxPixels pixels are refused before they are
+// decoded, since a small file can declare dimensions that exhaust memory.
+func Decode(data []byte, maxPixels int) (*Image, error) {
+	var (
+		decode func(io.Reader) (image.Image, error)
+		config func(io.Reader) (image.Config, error)
+		format string
+	)
+
+	switch {
+	case bytes.HasPrefix(data, []byte{0xFF, 0xD8, 0xFF}):
+		format, decode, config = "jpeg", jpeg.Decode, jpeg.DecodeConfig
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		format, decode, config = "png", png.Decode, png.DecodeConfig
+	case bytes.HasPrefix(data, []byte("GIF87a")), bytes.HasPrefix(data, []byte("GIF89a")):
+		// Only the first frame of animated GIFs is kept
+		format, decode, config = "gif", gif.Decode, gif.DecodeConfig
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+
+	cfg, err := config(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || int64(cfg.Width)*int64(cfg.Height) > int64(maxPixels) {
+		return nil, ErrTooManyPixels
+	}
+
+	img, err := decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if format == "jpeg" {
+		img = orient(img, exifOrientation(data))
+	}
+
+	return &Image{Image: img, Format: format}, nil
+}
+
+// Encode writes the image as JPEG when it came from a JPEG and as PNG
+// otherwise, returning the data and its content type.
+func Encode(img *Image) ([]byte, string, error) {
+	var buf bytes.Buffer
+	if img.Format == "jpeg" {
+		if err := jpeg.Encode(&buf, img.Image, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, "", err
+		}
+		return buf.Bytes(), "image/jpeg", nil
+	}
+
+	if err := png.Encode(&buf, img.Image); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), "image/png", nil
+}
+
+// Fit scales the image down so neither side exceeds maxSize, keeping the
+// aspect ratio. Images that already fit are returned unchanged.
+func Fit(img *Image, maxSize int) *Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= maxSize && height <= maxSize {
+		return img
+	}
+
+	if width >= height {
+		height = max(1, height*maxSize/width)
+		width = maxSize
+	} else {
+		width = max(1, width*maxSize/height)
+		height = maxSize
+	}
+
+	return &Image{Image: resize(img.Image, width, height), Format: img.Format}
+}
+
+// resize downsamples by averaging every source pixel that falls inside each
+// destination pixel, which avoids the aliasing of nearest-neighbour scaling.
+func resize(src image.Image, width, height int) image.Image {
+	bounds := src.Bounds()
+	srcWidth, srcHeight := bounds.Dx(), bounds.Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*srcHeight/height
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*srcHeight/height)
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*srcWidth/width
+			x1 := max(x0+1, bounds.Min.X+(x+1)*srcWidth/width)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := src.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+					n++
+				}
+			}
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r / n),
+				G: uint16(g / n),
+				B: uint16(b / n),
+				A: uint16(a / n),
+			})
+		}
+	}
+
+	return dst
+}
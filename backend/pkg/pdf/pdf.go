@@ -0,0 +1,189 @@
+// Package pdf renders simple text-only documents as PDF using the standard
+// Helvetica fonts, so no font files or external libraries are required.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	pageWidth  = 612 // US Letter, in points
+	pageHeight = 792
+	margin     = 72
+
+	headingSize = 16
+	bodySize    = 11
+	lineSpacing = 1.4
+)
+
+type line struct {
+	text string
+	size float64
+	bold bool
+}
+
+// Document accumulates lines of text and lays them out on as many pages as needed.
+type Document struct {
+	lines []line
+}
+
+func New() *Document {
+	return &Document{}
+}
+
+// Heading adds a bold heading, wrapping it to the page width.
+func (d *Document) Heading(text string) {
+	for _, wrapped := range wrap(text, maxChars(headingSize)) {
+		d.lines = append(d.lines, line{text: wrapped, size: headingSize, bold: true})
+	}
+}
+
+// Text adds a paragraph, wrapping it to the page width. Newlines in the text
+// start new lines.
+func (d *Document) Text(text string) {
+	for _, paragraph := range strings.Split(text, "\n") {
+		for _, wrapped := range wrap(paragraph, maxChars(bodySize)) {
+			d.lines = append(d.lines, line{text: wrapped, size: bodySize})
+		}
+	}
+}
+
+// Field adds a bold "label: value" line, wrapping it to the page width.
+func (d *Document) Field(label, value string) {
+	for _, wrapped := range wrap(label+": "+value, maxChars(bodySize)) {
+		d.lines = append(d.lines, line{text: wrapped, size: bodySize, bold: true})
+	}
+}
+
+// Blank adds an empty line.
+func (d *Document) Blank() {
+	d.lines = append(d.lines, line{size: bodySize})
+}
+
+// Bytes renders the document.
+func (d *Document) Bytes() []byte {
+	pages := d.paginate()
+
+	var buf bytes.Buffer
+	var offsets []int
+	addObject := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+
+	// Objects 1-4 are fixed; each page then adds a page and a content object
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+i*2)
+	}
+	addObject("<< /Type /Catalog /Pages 2 0 R >>")
+	addObject(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	addObject("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	addObject("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, page := range pages {
+		addObject(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] "+
+			"/Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, 6+i*2))
+		addObject(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(page), page))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buf.Bytes()
+}
+
+// paginate lays the lines out top to bottom and returns one content stream per page.
+func (d *Document) paginate() []string {
+	var pages []string
+	var content strings.Builder
+	y := float64(pageHeight - margin)
+
+	for _, l := range d.lines {
+		height := l.size * lineSpacing
+		if y-height < margin && content.Len() > 0 {
+			pages = append(pages, content.String())
+			content.Reset()
+			y = pageHeight - margin
+		}
+		y -= height
+
+		if l.text == "" {
+			continue
+		}
+		font := "F1"
+		if l.bold {
+			font = "F2"
+		}
+		fmt.Fprintf(&content, "BT /%s %.0f Tf %d %.1f Td (%s) Tj ET\n", font, l.size, margin, y, escape(l.text))
+	}
+
+	return append(pages, content.String())
+}
+
+// maxChars approximates how many Helvetica characters fit on a line.
+func maxChars(size float64) int {
+	return int(float64(pageWidth-2*margin) / (size * 0.5))
+}
+
+func wrap(text string, width int) []string {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return []string{""}
+	}
+
+	var lines []string
+	current := words[0]
+	for _, word := range words[1:] {
+		if utf8.RuneCountInString(current)+1+utf8.RuneCountInString(word) > width {
+			lines = append(lines, current)
+			current = word
+			continue
+		}
+		current += " " + word
+	}
+	return append(lines, current)
+}
+
+// winAnsi maps the characters WinAnsiEncoding places in 0x80-0x9F. Latin-1
+// characters from 0xA0 keep their code.
+var winAnsi = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87,
+	'ˆ': 0x88, '‰': 0x89, 'Š': 0x8A, '‹': 0x8B, 'Œ': 0x8C, 'Ž': 0x8E,
+	'‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97,
+	'˜': 0x98, '™': 0x99, 'š': 0x9A, '›': 0x9B, 'œ': 0x9C, 'ž': 0x9E, 'Ÿ': 0x9F,
+}
+
+// escape makes text safe for a PDF string literal in the fonts'
+// WinAnsiEncoding, writing non-ASCII characters as octal escapes. Characters
+// the encoding cannot represent are replaced, since the standard fonts cannot
+// show them.
+func escape(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 32 && r <= 126:
+			b.WriteRune(r)
+		case r >= 0xA0 && r <= 0xFF:
+			fmt.Fprintf(&b, "\\%03o", r)
+		case winAnsi[r] != 0:
+			fmt.Fprintf(&b, "\\%03o", winAnsi[r])
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}